@@ -102,6 +102,10 @@ type internalContext struct {
 	// current database name, if known. This is maintained on a best-effort basis.
 	dbName string
 
+	// lastQuery is the last query sent to the server. It is re-executed
+	// by \gexec and \watch when the query buffer is empty.
+	lastQuery string
+
 	// hook to run once, then clear, after running the next batch of statements.
 	afterRun func()
 
@@ -9,6 +9,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"io"
 	"os"
@@ -59,6 +60,8 @@ Query Buffer
   \p                during a multi-line statement, show the SQL entered so far.
   \r                during a multi-line statement, erase all the SQL entered so far.
   \| CMD            run an external command and run its output as SQL statements.
+  \gexec            run the query buffer (or the last query), then run each value in its result as a SQL statement.
+  \watch [INTERVAL] run the query buffer (or the last query) repeatedly until it fails (default interval 2s).
 
 Connection
   \info             display server details including connection strings.
@@ -873,6 +876,150 @@ func (c *cliState) pipeSyscmd(line string, nextState, errState cliStateEnum) cli
 	return nextState
 }
 
+// bufferOrLastQuery returns the SQL entered so far in a multi-line
+// statement, or the last query executed by the shell if the query
+// buffer is empty. It is used by the client-side commands that
+// re-execute a query, for compatibility with psql.
+func (c *cliState) bufferOrLastQuery(cmdName string) (string, error) {
+	if query := strings.TrimSpace(strings.Join(c.partialLines, "\n")); query != "" {
+		return query, nil
+	}
+	if c.iCtx.lastQuery == "" {
+		return "", errors.WithHint(
+			errors.Newf("%s: no query to execute", cmdName),
+			"Enter a query without a terminating semicolon, or run a query first.")
+	}
+	return c.iCtx.lastQuery, nil
+}
+
+// handleGexec runs the query buffer (or the last query), then executes
+// each non-NULL value in its result as a SQL statement, in row order
+// and then column order.
+func (c *cliState) handleGexec(cmd []string, nextState, errState cliStateEnum) cliStateEnum {
+	if len(cmd) > 0 {
+		return c.invalidSyntax(errState)
+	}
+	query, err := c.bufferOrLastQuery(`\gexec`)
+	if err != nil {
+		return c.cliError(errState, err)
+	}
+
+	var stmts []string
+	if err := c.runWithInterruptableCtx(func(ctx context.Context) (resErr error) {
+		rows, err := c.conn.Query(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer func() { resErr = errors.CombineErrors(resErr, rows.Close()) }()
+		vals := make([]driver.Value, len(rows.Columns()))
+		for {
+			if err := rows.Next(vals); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			for _, v := range vals {
+				switch x := v.(type) {
+				case nil:
+				case []byte:
+					stmts = append(stmts, string(x))
+				default:
+					stmts = append(stmts, fmt.Sprint(x))
+				}
+			}
+		}
+	}); err != nil {
+		return c.cliError(errState, err)
+	}
+
+	for i, stmt := range stmts {
+		c.concatLines = stmt
+		if c.doRunStatements(nextState) == cliStop {
+			return cliStop
+		}
+		if c.exitErr != nil && c.singleStatement && i < len(stmts)-1 {
+			// doRunStatements does not print errors in this mode; print
+			// it now because we don't get a chance later.
+			clierror.OutputError(c.iCtx.stderr, c.exitErr, true /*showSeverity*/, false /*verbose*/)
+		}
+	}
+	// The generated statements do not replace the generating query as
+	// the target of a subsequent \gexec or \watch.
+	c.iCtx.lastQuery = query
+	return nextState
+}
+
+// defaultWatchInterval is the interval used by \watch when none is
+// specified. This is the same default as psql.
+const defaultWatchInterval = 2 * time.Second
+
+// handleWatch runs the query buffer (or the last query) repeatedly
+// until it fails or is interrupted with Ctrl+C.
+func (c *cliState) handleWatch(cmd []string, nextState, errState cliStateEnum) cliStateEnum {
+	interval := defaultWatchInterval
+	switch len(cmd) {
+	case 0:
+	case 1:
+		// Like psql, accept a number of seconds; also accept a
+		// duration, like the --watch flag.
+		if secs, err := strconv.ParseFloat(cmd[0], 64); err == nil {
+			interval = time.Duration(secs * float64(time.Second))
+		} else if interval, err = time.ParseDuration(cmd[0]); err != nil {
+			return c.invalidSyntax(errState)
+		}
+		if interval <= 0 {
+			return c.invalidSyntax(errState)
+		}
+	default:
+		return c.invalidSyntax(errState)
+	}
+	query, err := c.bufferOrLastQuery(`\watch`)
+	if err != nil {
+		return c.cliError(errState, err)
+	}
+
+	for {
+		c.concatLines = query
+		if resState := c.doRunStatements(nextState); resState == cliStop || c.exitErr != nil {
+			// Like the --watch flag, stop watching upon the first error.
+			return resState
+		}
+		if c.sleepInterruptible(interval) {
+			return nextState
+		}
+	}
+}
+
+// sleepInterruptible waits for the given duration. In interactive
+// shells, the wait can be interrupted with Ctrl+C, in which case it
+// returns true.
+func (c *cliState) sleepInterruptible(d time.Duration) (interrupted bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if c.cliCtx.IsInteractive {
+		// Inform the Ctrl+C handler that it should stop the wait, in
+		// the same way as it would cancel an executing query.
+		doneCh := make(chan struct{})
+		defer func() { close(doneCh) }()
+		c.iCtx.mu.Lock()
+		c.iCtx.mu.cancelFn = func(context.Context) error { cancel(); return nil }
+		c.iCtx.mu.doneCh = doneCh
+		c.iCtx.mu.Unlock()
+		defer func() {
+			c.iCtx.mu.Lock()
+			c.iCtx.mu.cancelFn = nil
+			c.iCtx.mu.doneCh = nil
+			c.iCtx.mu.Unlock()
+		}()
+	}
+	select {
+	case <-time.After(d):
+		return false
+	case <-ctx.Done():
+		return true
+	}
+}
+
 // rePromptFmt recognizes every substitution pattern in the prompt format string.
 var rePromptFmt = regexp.MustCompile("(%.)")
 
@@ -1487,6 +1634,12 @@ func (c *cliState) doHandleCliCmd(loopState, nextState cliStateEnum) cliStateEnu
 	case `\|`:
 		return c.pipeSyscmd(c.lastInputLine, nextState, errState)
 
+	case `\gexec`:
+		return c.handleGexec(cmd[1:], cliStartLine, errState)
+
+	case `\watch`:
+		return c.handleWatch(cmd[1:], cliStartLine, errState)
+
 	case `\h`:
 		return c.handleHelp(cmd[1:], loopState, errState)
 
@@ -2096,6 +2249,11 @@ func (c *cliState) doRunStatements(nextState cliStateEnum) cliStateEnum {
 	// status.
 	c.exitErr = nil
 
+	// Remember the query for \gexec and \watch.
+	if !c.inCopy() {
+		c.iCtx.lastQuery = c.concatLines
+	}
+
 	// Once we send something to the server, the txn status may change arbitrarily.
 	// Clear the known state so that further entries do not assume anything.
 	c.lastKnownTxnStatus = " ?"
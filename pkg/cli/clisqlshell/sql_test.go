@@ -184,6 +184,9 @@ func Example_sql_watch() {
 
 	c.RunWithArgs([]string{`sql`, `-e`, `create table d(x int); insert into d values(3)`})
 	c.RunWithArgs([]string{`sql`, `--watch`, `.1s`, `-e`, `update d set x=x-1 returning 1/x as dec`})
+	// \watch re-runs the last query, and also stops on error.
+	c.RunWithArgs([]string{`sql`, `-e`, `update d set x=3`})
+	c.RunWithArgs([]string{`sql`, `-e`, `update d set x=x-1 returning 1/x as dec`, `-e`, `\watch .1`})
 
 	// Output:
 	// sql -e create table d(x int); insert into d values(3)
@@ -196,6 +199,39 @@ func Example_sql_watch() {
 	// 1.0000000000000000000
 	// ERROR: division by zero
 	// SQLSTATE: 22012
+	// sql -e update d set x=3
+	// UPDATE 1
+	// sql -e update d set x=x-1 returning 1/x as dec -e \watch .1
+	// dec
+	// 0.50000000000000000000
+	// dec
+	// 1.0000000000000000000
+	// ERROR: division by zero
+	// SQLSTATE: 22012
+}
+
+func Example_sql_gexec() {
+	c := cli.NewCLITest(cli.TestCLIParams{})
+	defer c.Cleanup()
+
+	// \gexec runs every non-NULL value of the last query's result.
+	c.RunWithArgs([]string{`sql`, `-e`, `select 'select 1 as one' as a, 'select 2 as two' as b union all select NULL, 'select 3 as three'`, `-e`, `\gexec`})
+	c.RunWithArgs([]string{`sql`, `-e`, `\gexec`})
+
+	// Output:
+	// sql -e select 'select 1 as one' as a, 'select 2 as two' as b union all select NULL, 'select 3 as three' -e \gexec
+	// a	b
+	// select 1 as one	select 2 as two
+	// NULL	select 3 as three
+	// one
+	// 1
+	// two
+	// 2
+	// three
+	// 3
+	// sql -e \gexec
+	// ERROR: -e: \gexec: no query to execute
+	// HINT: Enter a query without a terminating semicolon, or run a query first.
 }
 
 func Example_misc_table() {
@@ -89,6 +89,19 @@ func (e *externalConnectionNotFoundError) Error() string {
 func LoadExternalConnection(
 	ctx context.Context, name string, txn isql.Txn,
 ) (ExternalConnection, error) {
+	ec, err := LoadMutableExternalConnection(ctx, name, txn)
+	if err != nil {
+		return nil, err
+	}
+	return ec, nil
+}
+
+// LoadMutableExternalConnection loads an external connection record from the
+// `system.external_connections` table so that it can be modified and
+// persisted with Update.
+func LoadMutableExternalConnection(
+	ctx context.Context, name string, txn isql.Txn,
+) (*MutableExternalConnection, error) {
 	// Loading an External Connection is only allowed for users with the `USAGE`
 	// privilege. We run the query as `node` since the user might not have
 	// `SELECT` on the system table.
@@ -354,6 +367,44 @@ func (e *MutableExternalConnection) Create(ctx context.Context, txn isql.Txn) er
 	return e.InitFromDatums(row, retCols)
 }
 
+// Update persists the changes made to this external connection in the
+// system.external_connections table, and bumps its updated timestamp.
+//
+// If an error is returned, it is callers responsibility to handle it (e.g.
+// rollback transaction).
+func (e *MutableExternalConnection) Update(ctx context.Context, txn isql.Txn) error {
+	cols, qargs, err := e.marshalChanges()
+	if err != nil {
+		return err
+	}
+	if len(cols) == 0 {
+		return nil
+	}
+
+	sets := make([]string, len(cols))
+	for i, col := range cols {
+		sets[i] = fmt.Sprintf("%s = $%d", col, i+1)
+	}
+	qargs = append(qargs, tree.NewDString(e.rec.ConnectionName))
+
+	// ALTER EXTERNAL CONNECTION is only allowed for users with the `DROP`
+	// privilege on this object. We run the query as `node` since the user might
+	// not have `UPDATE` on the system table.
+	updateQuery := "UPDATE system.external_connections SET %s, updated = now() WHERE connection_name = $%d"
+	n, err := txn.ExecEx(ctx, "ExternalConnection.Update", txn.KV(),
+		sessiondata.NodeUserSessionDataOverride,
+		fmt.Sprintf(updateQuery, strings.Join(sets, ", "), len(qargs)),
+		qargs...,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update external connection")
+	}
+	if n != 1 {
+		return &externalConnectionNotFoundError{connectionName: e.rec.ConnectionName}
+	}
+	return nil
+}
+
 // marshalChanges marshals all changes in the in-memory representation and returns
 // the names of the columns and marshaled values.
 func (e *MutableExternalConnection) marshalChanges() ([]string, []interface{}, error) {
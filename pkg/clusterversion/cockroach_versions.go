@@ -210,6 +210,16 @@ const (
 	// V25_1_AddJobsColumns added new columns to system.jobs.
 	V25_1_AddJobsColumns
 
+	// V25_1_NotificationsTable adds the system.notifications table, through
+	// which the notifications sent with NOTIFY are delivered to the listening
+	// sessions of all SQL instances.
+	V25_1_NotificationsTable
+
+	// V25_1_LargeObjectsTable adds the system.large_objects and
+	// system.large_object_metadata tables, which store the contents and the
+	// owners of the large objects created through the lo_* builtins.
+	V25_1_LargeObjectsTable
+
 	// *************************************************
 	// Step (1) Add new versions above this comment.
 	// Do not add new versions to a patch release.
@@ -255,6 +265,8 @@ var versionTable = [numKeys]roachpb.Version{
 	V25_1_BatchStreamRPC:            {Major: 24, Minor: 3, Internal: 10},
 	V25_1_PreparedTransactionsTable: {Major: 24, Minor: 3, Internal: 12},
 	V25_1_AddJobsColumns:            {Major: 24, Minor: 3, Internal: 14},
+	V25_1_NotificationsTable:        {Major: 24, Minor: 3, Internal: 16},
+	V25_1_LargeObjectsTable:         {Major: 24, Minor: 3, Internal: 18},
 
 	// *************************************************
 	// Step (2): Add new versions above this comment.
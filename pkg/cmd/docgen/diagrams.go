@@ -415,6 +415,11 @@ var specs = []stmtSpec{
 		inline:  []string{"opt_for_roles", "role_or_group_or_user", "name_list", "opt_in_schemas", "schema_name_list", "abbreviated_grant_stmt", "opt_with_grant_option", "target_object_type", "abbreviated_revoke_stmt", "opt_drop_behavior"},
 		nosplit: true,
 	},
+	{
+		name:    "alter_external_connection_stmt",
+		replace: map[string]string{"'CONNECTION' string_or_placeholder 'AS' string_or_placeholder": "'CONNECTION' connection_name 'AS' connection_URI"},
+		unlink:  []string{"connection_name", "connection_URI"},
+	},
 	{
 		name:    "alter_index",
 		stmt:    "alter_index_stmt",
@@ -1535,6 +1540,10 @@ var specs = []stmtSpec{
 	{
 		name: "show_partitions_stmt",
 	},
+	{
+		name: "show_protected_timestamps",
+		stmt: "show_protected_timestamps_stmt",
+	},
 	{
 		name: "show_regions",
 		stmt: "show_regions_stmt",
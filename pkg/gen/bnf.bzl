@@ -25,6 +25,7 @@ BNF_SRCS = [
     "//docs/generated/sql/bnf:alter_database_to_schema_stmt.bnf",
     "//docs/generated/sql/bnf:alter_ddl_stmt.bnf",
     "//docs/generated/sql/bnf:alter_default_privileges_stmt.bnf",
+    "//docs/generated/sql/bnf:alter_external_connection_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_dep_extension_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_options_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_owner_stmt.bnf",
@@ -249,6 +250,7 @@ BNF_SRCS = [
     "//docs/generated/sql/bnf:show_locality_stmt.bnf",
     "//docs/generated/sql/bnf:show_partitions_stmt.bnf",
     "//docs/generated/sql/bnf:show_procedures_stmt.bnf",
+    "//docs/generated/sql/bnf:show_protected_timestamps.bnf",
     "//docs/generated/sql/bnf:show_range_for_row_stmt.bnf",
     "//docs/generated/sql/bnf:show_ranges_stmt.bnf",
     "//docs/generated/sql/bnf:show_regions.bnf",
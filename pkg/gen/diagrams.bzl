@@ -26,6 +26,7 @@ DIAGRAMS_SRCS = [
     "//docs/generated/sql/bnf:alter_database_to_schema.html",
     "//docs/generated/sql/bnf:alter_ddl.html",
     "//docs/generated/sql/bnf:alter_default_privileges.html",
+    "//docs/generated/sql/bnf:alter_external_connection.html",
     "//docs/generated/sql/bnf:alter_func.html",
     "//docs/generated/sql/bnf:alter_func_dep_extension.html",
     "//docs/generated/sql/bnf:alter_func_options.html",
@@ -248,6 +249,7 @@ DIAGRAMS_SRCS = [
     "//docs/generated/sql/bnf:show_locality.html",
     "//docs/generated/sql/bnf:show_partitions.html",
     "//docs/generated/sql/bnf:show_procedures.html",
+    "//docs/generated/sql/bnf:show_protected_timestamps.html",
     "//docs/generated/sql/bnf:show_range_for_row.html",
     "//docs/generated/sql/bnf:show_ranges.html",
     "//docs/generated/sql/bnf:show_regions.html",
@@ -38,6 +38,7 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:alter_database_to_schema_stmt.bnf",
     "//docs/generated/sql/bnf:alter_ddl_stmt.bnf",
     "//docs/generated/sql/bnf:alter_default_privileges_stmt.bnf",
+    "//docs/generated/sql/bnf:alter_external_connection_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_dep_extension_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_options_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_owner_stmt.bnf",
@@ -180,9 +181,11 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:legacy_transaction_stmt.bnf",
     "//docs/generated/sql/bnf:like_table_option_list.bnf",
     "//docs/generated/sql/bnf:limit_clause.bnf",
+    "//docs/generated/sql/bnf:listen_stmt.bnf",
     "//docs/generated/sql/bnf:move_cursor_stmt.bnf",
     "//docs/generated/sql/bnf:nonpreparable_set_stmt.bnf",
     "//docs/generated/sql/bnf:not_null_column_level.bnf",
+    "//docs/generated/sql/bnf:notify_stmt.bnf",
     "//docs/generated/sql/bnf:offset_clause.bnf",
     "//docs/generated/sql/bnf:on_conflict.bnf",
     "//docs/generated/sql/bnf:opt_frame_clause.bnf",
@@ -262,6 +265,7 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:show_locality_stmt.bnf",
     "//docs/generated/sql/bnf:show_partitions_stmt.bnf",
     "//docs/generated/sql/bnf:show_procedures_stmt.bnf",
+    "//docs/generated/sql/bnf:show_protected_timestamps.bnf",
     "//docs/generated/sql/bnf:show_range_for_row_stmt.bnf",
     "//docs/generated/sql/bnf:show_ranges_stmt.bnf",
     "//docs/generated/sql/bnf:show_regions.bnf",
@@ -0,0 +1,100 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/syntheticprivilege"
+	"github.com/cockroachdb/errors"
+)
+
+const alterExternalConnectionOp = "ALTER EXTERNAL CONNECTION"
+
+type alterExternalConnectionNode struct {
+	zeroInputPlanNode
+	n *tree.AlterExternalConnection
+}
+
+// AlterExternalConnection represents an ALTER EXTERNAL CONNECTION statement.
+func (p *planner) AlterExternalConnection(
+	_ context.Context, n *tree.AlterExternalConnection,
+) (planNode, error) {
+	return &alterExternalConnectionNode{n: n}, nil
+}
+
+func (c *alterExternalConnectionNode) startExec(params runParams) error {
+	return params.p.alterExternalConnection(params, c.n)
+}
+
+// alterExternalConnection replaces the endpoint of an existing External
+// Connection. Since jobs resolve `external://` URIs each time they open the
+// underlying resource, this allows credentials to be rotated without
+// recreating the connection or restarting the jobs that use it.
+func (p *planner) alterExternalConnection(
+	params runParams, n *tree.AlterExternalConnection,
+) error {
+	exprEval := p.ExprEvaluator(alterExternalConnectionOp)
+	name, err := exprEval.String(params.ctx, n.ConnectionLabel)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve External Connection name")
+	}
+	endpoint, err := exprEval.String(params.ctx, n.As)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve External Connection endpoint")
+	}
+
+	// Altering an External Connection is equivalent to dropping and recreating
+	// it, so we require the privileges needed for both.
+	if err := params.p.CheckPrivilege(params.ctx, syntheticprivilege.GlobalPrivilegeObject,
+		privilege.EXTERNALCONNECTION); err != nil {
+		return pgerror.New(
+			pgcode.InsufficientPrivilege,
+			"only users with the EXTERNALCONNECTION system privilege are allowed to ALTER EXTERNAL CONNECTION")
+	}
+	ecPrivilege := &syntheticprivilege.ExternalConnectionPrivilege{
+		ConnectionName: name,
+	}
+	if err := p.CheckPrivilege(params.ctx, ecPrivilege, privilege.DROP); err != nil {
+		return err
+	}
+
+	txn := p.InternalSQLTxn()
+	ex, err := externalconn.LoadMutableExternalConnection(params.ctx, name, txn)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve External Connection")
+	}
+
+	if err = logAndSanitizeExternalConnectionURI(params.ctx, endpoint); err != nil {
+		return errors.Wrap(err, "failed to log and sanitize External Connection")
+	}
+
+	exConn, err := externalconn.ExternalConnectionFromURI(
+		params.ctx, p.makeExternalConnEnv(params), endpoint,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to construct External Connection details")
+	}
+	// Users of the External Connection expect a resource of the type it was
+	// created with, so the new endpoint must be of the same type.
+	if exConn.ConnectionType() != ex.ConnectionType() {
+		return pgerror.Newf(pgcode.InvalidParameterValue,
+			"cannot change the type of External Connection %s from %s to %s",
+			name, ex.ConnectionType(), exConn.ConnectionType())
+	}
+	ex.SetConnectionDetails(*exConn.ConnectionProto())
+
+	return ex.Update(params.ctx, txn)
+}
+
+func (c *alterExternalConnectionNode) Next(_ runParams) (bool, error) { return false, nil }
+func (c *alterExternalConnectionNode) Values() tree.Datums            { return nil }
+func (c *alterExternalConnectionNode) Close(_ context.Context)        {}
@@ -10,6 +10,7 @@ import (
 	"context"
 	gojson "encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/semenumpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
 	"github.com/cockroachdb/cockroach/pkg/sql/stats"
@@ -930,6 +932,54 @@ func (n *alterTableNode) Next(runParams) (bool, error) { return false, nil }
 func (n *alterTableNode) Values() tree.Datums          { return tree.Datums{} }
 func (n *alterTableNode) Close(context.Context)        {}
 
+// maybeStartIdentityAfterExistingValues adds a START option to the options of
+// the sequence backing an identity column added to an existing column, so
+// that the sequence does not generate values the column already holds. The
+// sequence starts one past the largest value (or the smallest, for a negative
+// INCREMENT). The options are returned unchanged if START was specified or if
+// the column holds no values.
+func maybeStartIdentityAfterExistingValues(
+	params runParams,
+	tableDesc catalog.TableDescriptor,
+	col catalog.Column,
+	seqOpts tree.SequenceOptions,
+) (tree.SequenceOptions, error) {
+	increment := int64(1)
+	for _, opt := range seqOpts {
+		switch opt.Name {
+		case tree.SeqOptStart:
+			return seqOpts, nil
+		case tree.SeqOptIncrement:
+			increment = *opt.IntVal
+		}
+	}
+	agg, next, limit := "max", int64(1), int64(math.MaxInt64)
+	if increment < 0 {
+		agg, next, limit = "min", -1, math.MinInt64
+	}
+	row, err := params.p.InternalSQLTxn().QueryRowEx(
+		params.ctx,
+		"identity-existing-values",
+		params.p.txn,
+		sessiondata.NodeUserSessionDataOverride,
+		fmt.Sprintf(`SELECT %s(%s) FROM [%d AS t]`, agg, tree.NameString(col.GetName()), tableDesc.GetID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if row == nil || row[0] == tree.DNull {
+		return seqOpts, nil
+	}
+	start := int64(tree.MustBeDInt(row[0]))
+	if start == limit {
+		return nil, pgerror.Newf(pgcode.SequenceGeneratorLimitExceeded,
+			"column %q of relation %q has no values left for an identity sequence",
+			col.GetName(), tableDesc.GetName())
+	}
+	start += next
+	return append(seqOpts, tree.SequenceOption{Name: tree.SeqOptStart, IntVal: &start}), nil
+}
+
 // applyColumnMutation applies the mutation specified in `mut` to the given
 // columnDescriptor, and saves the containing table descriptor. If the column's
 // dependencies on sequences change, it updates them as well.
@@ -1129,6 +1179,10 @@ func applyColumnMutation(
 			return errors.Newf("failed to create sequence %q for new identity column %q in %q", seqName, col.ColName(), tn)
 		}
 		colDef = newDef
+		seqOpts, err = maybeStartIdentityAfterExistingValues(params, tableDesc, col, seqOpts)
+		if err != nil {
+			return err
+		}
 
 		colOwnedSeqDesc, err := doCreateSequence(
 			ctx,
@@ -1502,7 +1556,8 @@ func injectTableStats(
 		return err
 	}
 
-	// Check that we're not injecting any forecasted stats.
+	// Check that we're not injecting any forecasted stats, and that the stats
+	// are internally consistent.
 	for i := range jsonStats {
 		if jsonStats[i].Name == jobspb.ForecastStatsName {
 			return errors.WithHintf(
@@ -1511,6 +1566,9 @@ func injectTableStats(
 				jobspb.ForecastStatsName,
 			)
 		}
+		if err := jsonStats[i].Validate(params.ctx, &params.p.semaCtx, params.EvalContext()); err != nil {
+			return pgerror.WithCandidateCode(err, pgcode.InvalidParameterValue)
+		}
 	}
 
 	// First, delete all statistics for the table. (We use the current transaction
@@ -27,6 +27,9 @@ var (
 		catconstants.StatementActivityTableName,
 		catconstants.TransactionActivityTableName,
 		catconstants.PreparedTransactionsTableName,
+		catconstants.NotificationsTableName,
+		catconstants.LargeObjectsTableName,
+		catconstants.LargeObjectMetadataTableName,
 	}
 
 	readWriteSystemTables = []catconstants.SystemTableName{
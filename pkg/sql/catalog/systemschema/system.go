@@ -1334,6 +1334,47 @@ CREATE TABLE system.prepared_transactions (
   CONSTRAINT "primary" PRIMARY KEY (global_id),
   FAMILY "primary" (global_id, transaction_id, transaction_key, prepared, owner, database, heuristic)
 );`
+
+	// NotificationsTableSchema stores the notifications sent with NOTIFY and
+	// pg_notify. Every SQL instance watches the table with a rangefeed and
+	// forwards new rows to its sessions listening on the channel. Rows are
+	// only needed until they have been picked up by the rangefeeds, so they
+	// are periodically deleted; the index on sent is used to find them.
+	NotificationsTableSchema = `
+CREATE TABLE system.notifications (
+  id       INT8         NOT NULL DEFAULT unique_rowid(),
+  channel  STRING       NOT NULL,
+  payload  STRING       NOT NULL,
+  pid      INT4         NOT NULL,
+  sent     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+  CONSTRAINT "primary" PRIMARY KEY (id),
+  INDEX notifications_sent_idx (sent),
+  FAMILY "primary" (id, channel, payload, pid, sent)
+);`
+
+	// LargeObjectsTableSchema stores the contents of the large objects created
+	// through the lo_* builtins, split into pages of largeobject.PageSize
+	// bytes. Page 0 of a large object always exists, even if it is empty, so
+	// that the size of the object can be determined from its last page.
+	LargeObjectsTableSchema = `
+CREATE TABLE system.large_objects (
+  loid    OID    NOT NULL,
+  pageno  INT4   NOT NULL,
+  data    BYTES  NOT NULL,
+  CONSTRAINT "primary" PRIMARY KEY (loid, pageno),
+  FAMILY "primary" (loid, pageno, data)
+);`
+
+	// LargeObjectMetadataTableSchema stores one row for each large object in
+	// system.large_objects, with the role that owns it. It is the equivalent
+	// of pg_largeobject_metadata in Postgres.
+	LargeObjectMetadataTableSchema = `
+CREATE TABLE system.large_object_metadata (
+  loid   OID     NOT NULL,
+  owner  STRING  NOT NULL,
+  CONSTRAINT "primary" PRIMARY KEY (loid),
+  FAMILY "primary" (loid, owner)
+);`
 )
 
 func pk(name string) descpb.IndexDescriptor {
@@ -1386,7 +1427,7 @@ const SystemDatabaseName = catconstants.SystemDatabaseName
 // release version).
 //
 // NB: Don't set this to clusterversion.Latest; use a specific version instead.
-var SystemDatabaseSchemaBootstrapVersion = clusterversion.V25_1_AddJobsColumns.Version()
+var SystemDatabaseSchemaBootstrapVersion = clusterversion.V25_1_LargeObjectsTable.Version()
 
 // MakeSystemDatabaseDesc constructs a copy of the system database
 // descriptor.
@@ -1583,6 +1624,9 @@ func MakeSystemTables() []SystemTable {
 		SystemJobStatusTable,
 		SystemJobMessageTable,
 		PreparedTransactionsTable,
+		NotificationsTable,
+		LargeObjectsTable,
+		LargeObjectMetadataTable,
 	}
 }
 
@@ -5273,6 +5317,89 @@ var (
 			pk("global_id"),
 		),
 	)
+
+	NotificationsTable = makeSystemTable(
+		NotificationsTableSchema,
+		systemTable(
+			catconstants.NotificationsTableName,
+			descpb.InvalidID, // dynamically assigned table ID
+			[]descpb.ColumnDescriptor{
+				{Name: "id", ID: 1, Type: types.Int, DefaultExpr: &uniqueRowIDString},
+				{Name: "channel", ID: 2, Type: types.String},
+				{Name: "payload", ID: 3, Type: types.String},
+				{Name: "pid", ID: 4, Type: types.Int4},
+				{Name: "sent", ID: 5, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ColumnNames: []string{"id", "channel", "payload", "pid", "sent"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4, 5},
+				},
+			},
+			pk("id"),
+			descpb.IndexDescriptor{
+				Name:                "notifications_sent_idx",
+				ID:                  2,
+				KeyColumnNames:      []string{"sent"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{5},
+				KeySuffixColumnIDs:  []descpb.ColumnID{1},
+				Version:             descpb.StrictIndexColumnIDGuaranteesVersion,
+			},
+		),
+	)
+
+	LargeObjectsTable = makeSystemTable(
+		LargeObjectsTableSchema,
+		systemTable(
+			catconstants.LargeObjectsTableName,
+			descpb.InvalidID, // dynamically assigned table ID
+			[]descpb.ColumnDescriptor{
+				{Name: "loid", ID: 1, Type: types.Oid},
+				{Name: "pageno", ID: 2, Type: types.Int4},
+				{Name: "data", ID: 3, Type: types.Bytes},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:            "primary",
+					ID:              0,
+					ColumnNames:     []string{"loid", "pageno", "data"},
+					ColumnIDs:       []descpb.ColumnID{1, 2, 3},
+					DefaultColumnID: 3,
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"loid", "pageno"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC, catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{1, 2},
+			},
+		),
+	)
+
+	LargeObjectMetadataTable = makeSystemTable(
+		LargeObjectMetadataTableSchema,
+		systemTable(
+			catconstants.LargeObjectMetadataTableName,
+			descpb.InvalidID, // dynamically assigned table ID
+			[]descpb.ColumnDescriptor{
+				{Name: "loid", ID: 1, Type: types.Oid},
+				{Name: "owner", ID: 2, Type: types.String},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:            "primary",
+					ColumnNames:     []string{"loid", "owner"},
+					ColumnIDs:       []descpb.ColumnID{1, 2},
+					DefaultColumnID: 2,
+				},
+			},
+			pk("loid"),
+		),
+	)
 )
 
 // SpanConfigurationsTableName represents system.span_configurations.
@@ -163,8 +163,11 @@ func supportedNatively(core *execinfrapb.ProcessorCoreUnion) error {
 		return nil
 
 	case core.Aggregator != nil:
-		for _, agg := range core.Aggregator.Aggregations {
-			if agg.FilterColIdx != nil {
+		if hasFilteringAggregation(core.Aggregator) {
+			// FILTER clauses are only supported by the hash aggregator. It
+			// doesn't produce a row for a scalar aggregation over an empty
+			// input, and its output ordering is arbitrary.
+			if len(core.Aggregator.GroupCols) == 0 || len(core.Aggregator.OutputOrdering.Columns) > 0 {
 				return errFilteringAggregation
 			}
 		}
@@ -259,6 +262,17 @@ var (
 	)
 )
 
+// hasFilteringAggregation returns whether any of the aggregate functions has a
+// FILTER clause.
+func hasFilteringAggregation(spec *execinfrapb.AggregatorSpec) bool {
+	for _, agg := range spec.Aggregations {
+		if agg.FilterColIdx != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func canWrap(mode sessiondatapb.VectorizeExecMode, core *execinfrapb.ProcessorCoreUnion) error {
 	if mode == sessiondatapb.VectorizeExperimentalAlways && core.JoinReader == nil && core.LocalPlanNode == nil {
 		return errExperimentalWrappingProhibited
@@ -976,6 +990,10 @@ func NewColOperator(
 			if err != nil {
 				return r, err
 			}
+			// The ordered aggregator doesn't support FILTER clauses, so we use
+			// the hash aggregator even if the input is ordered. No output
+			// ordering is required in that case; see supportedNatively.
+			needHash = needHash || hasFilteringAggregation(aggSpec)
 			// Make a copy of the evalCtx since we're modifying it below.
 			evalCtx := flowCtx.NewEvalCtx()
 			newAggArgs := &colexecagg.NewAggregatorArgs{
@@ -1000,11 +1018,15 @@ func NewColOperator(
 				// args.TestingKnobs.DiskSpillingDisabled and always instantiate
 				// a disk-backed one here.
 				diskSpillingDisabled := !colexec.HashAggregationDiskSpillingEnabled.Get(&flowCtx.Cfg.Settings.SV)
+				// The external hash aggregator falls back to the ordered
+				// aggregator, which doesn't support FILTER clauses, so
+				// filtering aggregations don't spill to disk, like the
+				// row-based aggregator.
+				diskSpillingDisabled = diskSpillingDisabled || hasFilteringAggregation(aggSpec)
 				if diskSpillingDisabled {
-					// The disk spilling is disabled by the cluster setting, so
-					// we give unlimited memory accounts to the in-memory hash
-					// aggregator and all of its components and don't set up the
-					// disk spiller.
+					// The disk spilling is disabled, so we give unlimited
+					// memory accounts to the in-memory hash aggregator and all
+					// of its components and don't set up the disk spiller.
 					accounts := args.MonitorRegistry.CreateUnlimitedMemAccounts(
 						ctx, flowCtx, opName, spec.ProcessorID, 3, /* numAccounts */
 					)
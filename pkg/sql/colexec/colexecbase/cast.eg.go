@@ -94,6 +94,11 @@ func GetCastOperator(
 	if fromType.Family() == types.UnknownFamily {
 		return &castOpNullAny{castOpBase: base}, nil
 	}
+	if toType.Oid() == oid.T_xml && fromType.Oid() != oid.T_xml {
+		// Casts to XML must validate their input, which is only done by the
+		// row-by-row cast.
+		return nil, errUnhandledCast
+	}
 	if isIdentityCast(fromType, toType) {
 		// bpchars require special handling.
 		if toType.Oid() == oid.T_bpchar {
@@ -641,6 +646,9 @@ func IsCastSupported(fromType, toType *types.T) bool {
 	if fromType.Family() == types.UnknownFamily {
 		return true
 	}
+	if toType.Oid() == oid.T_xml && fromType.Oid() != oid.T_xml {
+		return false
+	}
 	if isIdentityCast(fromType, toType) {
 		return true
 	}
@@ -88,10 +88,13 @@ func TestExternalHashAggregator(t *testing.T) {
 				// aggregator is planned.
 				continue
 			}
-			if tc.aggFilter != nil {
-				// Filtering aggregation is not supported with the ordered
-				// aggregation which is required for the external hash
-				// aggregator in the fallback strategy.
+			// Filtering aggregation is not supported with the ordered
+			// aggregation which is required for the external hash aggregator
+			// in the fallback strategy, so the in-memory hash aggregator is
+			// planned for it even if the disk spilling is enabled.
+			inMemoryOnly := !cfg.diskSpillingEnabled || tc.aggFilter != nil
+			if tc.aggFilter != nil && len(tc.spec.OutputOrdering.Columns) > 0 {
+				// The row-based aggregator is wrapped in this case.
 				continue
 			}
 			log.Infof(ctx, "diskSpillingEnabled=%t/spillForced=%t/memoryLimitBytes=%d/numRepartitions=%d/%s", cfg.diskSpillingEnabled, cfg.spillForced, cfg.memoryLimitBytes, numForcedRepartitions, tc.name)
@@ -106,7 +109,7 @@ func TestExternalHashAggregator(t *testing.T) {
 				verifier = colexectestutils.PartialOrderedVerifier
 			}
 			var numExpectedClosers int
-			if cfg.diskSpillingEnabled {
+			if !inMemoryOnly {
 				// The external sorter (accounting for two closers), the disk
 				// spiller, and the external hash aggregator should be added as
 				// Closers.
@@ -143,7 +146,7 @@ func TestExternalHashAggregator(t *testing.T) {
 					queueCfg, sem, numForcedRepartitions, &monitorRegistry,
 				)
 				require.Equal(t, numExpectedClosers, len(closers))
-				if !cfg.diskSpillingEnabled {
+				if inMemoryOnly {
 					// Sanity check that indeed only the in-memory hash
 					// aggregator was created.
 					_, isHashAgg := MaybeUnwrapInvariantsChecker(op).(*hashAggregator)
@@ -45,6 +45,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/execstats"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxrecommendations"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxusage"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
@@ -1135,6 +1136,14 @@ func (s *Server) newConnExecutor(
 		totalActiveTimeStopWatch:  timeutil.NewStopWatch(),
 		txnFingerprintIDCache:     NewTxnFingerprintIDCache(ctx, s.cfg.Settings, &txnFingerprintIDCacheAcc),
 		txnFingerprintIDAcc:       &txnFingerprintIDCacheAcc,
+		notifications:             sessionNotifications{registry: s.cfg.NotificationRegistry},
+	}
+	if executorType == executorTypeExec {
+		// Wake up the session when notifications are queued for it while it's
+		// idle. The internal executor doesn't support notifications.
+		ex.notifications.onNotify = func() {
+			_ = stmtBuf.Push(ctx, DeliverNotifications{})
+		}
 	}
 	ex.rng.internal = rand.New(rand.NewSource(timeutil.Now().UnixNano()))
 
@@ -1319,6 +1328,9 @@ func (ex *connExecutor) close(ctx context.Context, closeType closeType) {
 	// Free any memory used by the stats collector.
 	ex.statsCollector.Close(ctx, ex.planner.extendedEvalCtx.SessionID)
 
+	// Stop listening on all channels.
+	ex.notifications.close()
+
 	var payloadErr error
 	if closeType == normalClose {
 		// We'll cleanup the SQL txn by creating a non-retriable (commit:true) event.
@@ -1464,6 +1476,10 @@ type connExecutor struct {
 	transitionCtx  transitionCtx
 	sessionTracing SessionTracing
 
+	// notifications tracks the channels the session is listening on and the
+	// notifications sent by the current transaction.
+	notifications sessionNotifications
+
 	// extraTxnState groups fields scoped to a SQL txn that are not handled by
 	// ex.state, above. The rule of thumb is that, if the state influences state
 	// transitions, it should live in state, otherwise it can live here.
@@ -1572,6 +1588,11 @@ type connExecutor struct {
 		// and are destroyed when the transaction finishes.
 		sqlCursors cursorMap
 
+		// largeObjects contains the large-object descriptors opened by the
+		// transaction through lo_open. They are closed when the transaction
+		// finishes.
+		largeObjects largeobject.Descriptors
+
 		// shouldExecuteOnTxnFinish indicates that ex.onTxnFinish will be called
 		// when txn is finished (either committed or aborted). It is true when
 		// txn is started but can remain false when txn is executed within
@@ -2160,6 +2181,16 @@ func (ex *connExecutor) resetExtraTxnState(ctx context.Context, ev txnEvent, pay
 		log.Warningf(ctx, "error closing cursors: %v", err)
 	}
 
+	// Close all large-object descriptors.
+	ex.extraTxnState.largeObjects.Reset()
+
+	// LISTEN, UNLISTEN and NOTIFY only take effect if the transaction commits.
+	if ev.eventType == txnCommit {
+		ex.notifications.commit()
+	} else {
+		ex.notifications.rollback()
+	}
+
 	switch ev.eventType {
 	case txnCommit, txnRollback, txnPrepare:
 		ex.extraTxnState.prepStmtsNamespaceAtTxnRewindPos.closeAllPortals(
@@ -2605,6 +2636,14 @@ func (ex *connExecutor) execCmd() (retErr error) {
 	case Flush:
 		// Closing the res will flush the connection's buffer.
 		res = ex.clientComm.CreateFlushResult(pos)
+	case DeliverNotifications:
+		// Notifications that arrive while a transaction is open are delivered
+		// by the Sync that follows the end of the transaction.
+		flushRes := ex.clientComm.CreateFlushResult(pos)
+		if ex.idleConn() {
+			ex.notifications.deliver(ctx, flushRes)
+		}
+		res = flushRes
 	default:
 		panic(errors.AssertionFailedf("unsupported command type: %T", cmd))
 	}
@@ -2694,6 +2733,11 @@ func (ex *connExecutor) execCmd() (retErr error) {
 				}
 			}
 		}
+		// Asynchronous notifications are delivered in between transactions,
+		// right before the ReadyForQuery message.
+		if _, ok := cmd.(Sync); ok && ex.idleConn() {
+			ex.notifications.deliver(ctx, res.(SyncResult))
+		}
 		res.Close(ctx, stateToTxnStatusIndicator(ex.machine.CurState()))
 	} else {
 		res.Discard()
@@ -2874,6 +2918,8 @@ func (ex *connExecutor) updateTxnRewindPosMaybe(
 				canAdvance = true
 			case Flush:
 				canAdvance = true
+			case DeliverNotifications:
+				canAdvance = true
 			default:
 				panic(errors.AssertionFailedf("unsupported cmd: %T", cmd))
 			}
@@ -3756,6 +3802,7 @@ func (ex *connExecutor) initEvalCtx(ctx context.Context, evalCtx *extendedEvalCo
 			Regions:                        p,
 			Gossip:                         p,
 			PreparedStatementState:         &ex.extraTxnState.prepStmtsNamespace,
+			LargeObjects:                   &ex.extraTxnState.largeObjects,
 			SessionDataStack:               ex.sessionDataStack,
 			ReCache:                        ex.server.reCache,
 			ToCharFormatCache:              ex.server.toCharFormatCache,
@@ -3780,6 +3827,7 @@ func (ex *connExecutor) initEvalCtx(ctx context.Context, evalCtx *extendedEvalCo
 		TxnModesSetter:       ex,
 		jobs:                 ex.extraTxnState.jobs,
 		validateDbZoneConfig: &ex.extraTxnState.validateDbZoneConfig,
+		notifications:        &ex.notifications,
 		statsProvider:        ex.server.sqlStats,
 		indexUsageStats:      ex.indexUsageStats,
 		statementPreparer:    ex,
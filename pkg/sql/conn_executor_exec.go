@@ -37,6 +37,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/physicalplan"
 	"github.com/cockroachdb/cockroach/pkg/sql/regions"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/asof"
@@ -473,6 +474,18 @@ func (ex *connExecutor) execStmtInOpenState(
 		stmt.ExpectedTypes = nil
 	}
 
+	// Special top-level handling for EXPLAIN EXECUTE, which explains the prepared
+	// statement instead.
+	if e, ok := ast.(*tree.Explain); ok {
+		if execute, ok := e.Statement.(*tree.Execute); ok {
+			var err error
+			if pinfo, err = ex.rewriteExplainExecute(ctx, &stmt, e, execute); err != nil {
+				return makeErrEvent(err)
+			}
+			ast = stmt.AST
+		}
+	}
+
 	// Special top-level handling for EXECUTE. This must happen after the handling
 	// for EXPLAIN ANALYZE (in order to support EXPLAIN ANALYZE EXECUTE) but
 	// before setting up the instrumentation helper.
@@ -1424,6 +1437,18 @@ func (ex *connExecutor) execStmtInOpenStateWithPausablePortal(
 		vars.stmt.ExpectedTypes = nil
 	}
 
+	// Special top-level handling for EXPLAIN EXECUTE, which explains the prepared
+	// statement instead.
+	if e, ok := vars.ast.(*tree.Explain); ok {
+		if execute, ok := e.Statement.(*tree.Execute); ok {
+			var err error
+			if pinfo, err = ex.rewriteExplainExecute(ctx, &vars.stmt, e, execute); err != nil {
+				return makeErrEvent(err)
+			}
+			vars.ast = vars.stmt.AST
+		}
+	}
+
 	// Special top-level handling for EXECUTE. This must happen after the handling
 	// for EXPLAIN ANALYZE (in order to support EXPLAIN ANALYZE EXECUTE) but
 	// before setting up the instrumentation helper.
@@ -3206,6 +3231,26 @@ func (ex *connExecutor) makeExecPlan(ctx context.Context, planner *planner) erro
 		ex.metrics.EngineMetrics.FullTableOrIndexScanCount.Inc(1)
 	}
 
+	if ex.executorType == executorTypeExec {
+		// Reject, or warn about, plans that are estimated to read more rows than
+		// allowed by the session settings.
+		sd := planner.EvalContext().SessionData()
+		rowsRead := planner.curPlan.instrumentation.totalScanRows
+		if sd.EstimatedRowsReadErr > 0 && rowsRead > float64(sd.EstimatedRowsReadErr) {
+			return errors.WithHint(
+				pgerror.Newf(pgcode.TooManyRows,
+					"query `%s` is estimated to read %.0f rows, which exceeds estimated_rows_read_err (%d)",
+					planner.stmt.SQL, rowsRead, sd.EstimatedRowsReadErr),
+				"try adding a more selective filter or increasing the `estimated_rows_read_err` session setting",
+			)
+		}
+		if sd.EstimatedRowsReadWarn > 0 && rowsRead > float64(sd.EstimatedRowsReadWarn) {
+			planner.BufferClientNotice(ctx, pgnotice.NewWithSeverityf("WARNING",
+				"query is estimated to read %.0f rows, which exceeds estimated_rows_read_warn (%d)",
+				rowsRead, sd.EstimatedRowsReadWarn))
+		}
+	}
+
 	// TODO(knz): Remove this accounting if/when savepoint rollbacks
 	// support rolling back over DDL.
 	if flags.IsSet(planFlagIsDDL) {
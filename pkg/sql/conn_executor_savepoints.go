@@ -100,6 +100,7 @@ func (ex *connExecutor) execSavepointInOpenState(
 		commitOnRelease: commitOnRelease,
 		kvToken:         token,
 		numDDL:          ex.extraTxnState.numDDL,
+		notifications:   ex.notifications.savepoint(),
 	}
 	savepoints.push(sp)
 	ex.sessionDataStack.PushTopClone()
@@ -204,6 +205,7 @@ func (ex *connExecutor) execRollbackToSavepointInOpenState(
 		ev, payload := ex.makeErrEvent(err, s)
 		return ev, payload
 	}
+	ex.notifications.rollbackToSavepoint(entry.notifications)
 
 	if err := ex.popSavepointsToIdx(s, idx); err != nil {
 		return ex.makeErrEvent(err, s)
@@ -288,6 +290,7 @@ func (ex *connExecutor) execRollbackToSavepointInAbortedState(
 	if err := ex.state.mu.txn.RollbackToSavepoint(ctx, entry.kvToken); err != nil {
 		return ex.makeErrEvent(err, s)
 	}
+	ex.notifications.rollbackToSavepoint(entry.notifications)
 
 	if entry.kvToken.Initial() {
 		return eventTxnRestart{}, nil
@@ -352,6 +355,12 @@ type savepoint struct {
 	// more DDL statements were executed since the savepoint's creation.
 	// TODO(knz): support partial DDL cancellation in pending txns.
 	numDDL int
+
+	// notifications is the state of the LISTEN, UNLISTEN and NOTIFY
+	// statements executed in the transaction at the time the savepoint was
+	// created. Rolling back to the savepoint discards the statements executed
+	// since.
+	notifications notificationsSavepoint
 }
 
 type savepointStack []savepoint
@@ -1719,6 +1719,15 @@ func TestVirtualPTSTable(t *testing.T) {
 			virtualRow.internalMeta)
 		require.Equal(t, []byte(`{"schemaObjects": {}}`), virtualRow.decodedTargets)
 		require.Equal(t, 0, virtualRow.numRanges)
+
+		// SHOW PROTECTED TIMESTAMPS resolves the job holding the record.
+		var holderType, jobType string
+		var holderID int64
+		sqlDB.QueryRow(t, `SELECT holder_type, holder_id, job_type FROM [SHOW PROTECTED TIMESTAMPS] WHERE id = $1`,
+			rec.ID.String()).Scan(&holderType, &holderID, &jobType)
+		require.Equal(t, "jobs", holderType)
+		require.Equal(t, int64(jobID), holderID)
+		require.Equal(t, "BACKUP", jobType)
 	})
 
 	t.Run("table-covers-all-meta-types", func(t *testing.T) {
@@ -1763,6 +1772,15 @@ func TestVirtualPTSTable(t *testing.T) {
 			"test-schedule", username.TestUserName().Normalized())), virtualRow.internalMeta)
 		require.Equal(t, []byte(`{"schemaObjects": {}}`), virtualRow.decodedTargets)
 		require.Equal(t, 0, virtualRow.numRanges)
+
+		// SHOW PROTECTED TIMESTAMPS resolves the schedule holding the record.
+		var holderType, holderDescription string
+		var holderID int64
+		sqlDB.QueryRow(t, `SELECT holder_type, holder_id, holder_description FROM [SHOW PROTECTED TIMESTAMPS] WHERE id = $1`,
+			rec.ID.String()).Scan(&holderType, &holderID, &holderDescription)
+		require.Equal(t, "schedules", holderType)
+		require.Equal(t, int64(sj.ScheduleID()), holderID)
+		require.Equal(t, "test-schedule", holderDescription)
 	})
 
 	// Assert that the table descriptor ids are correctly decoded and the number of ranges calculation is
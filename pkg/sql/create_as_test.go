@@ -278,6 +278,9 @@ func TestCreateAsShow(t *testing.T) {
 		{
 			sql: "SHOW FULL TABLE SCANS",
 		},
+		{
+			sql: "SHOW PROTECTED TIMESTAMPS",
+		},
 		{
 			sql: "SHOW DEFAULT PRIVILEGES",
 		},
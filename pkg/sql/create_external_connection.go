@@ -92,32 +92,10 @@ func (p *planner) createExternalConnection(
 		return errors.Wrap(err, "failed to log and sanitize External Connection")
 	}
 
-	var SkipCheckingExternalStorageConnection bool
-	var SkipCheckingKMSConnection bool
-	if tk := params.ExecCfg().ExternalConnectionTestingKnobs; tk != nil {
-		if tk.SkipCheckingExternalStorageConnection != nil {
-			SkipCheckingExternalStorageConnection = params.ExecCfg().ExternalConnectionTestingKnobs.SkipCheckingExternalStorageConnection()
-		}
-		if tk.SkipCheckingKMSConnection != nil {
-			SkipCheckingKMSConnection = params.ExecCfg().ExternalConnectionTestingKnobs.SkipCheckingKMSConnection()
-		}
-	}
-
-	env := externalconn.MakeExternalConnEnv(
-		params.ExecCfg().Settings,
-		&params.ExecCfg().ExternalIODirConfig,
-		params.ExecCfg().InternalDB,
-		p.User(),
-		params.ExecCfg().DistSQLSrv.ExternalStorageFromURI,
-		SkipCheckingExternalStorageConnection,
-		SkipCheckingKMSConnection,
-		&params.ExecCfg().DistSQLSrv.ServerConfig,
-	)
-
 	// Construct the ConnectionDetails for the external resource represented by
 	// the External Connection.
 	exConn, err := externalconn.ExternalConnectionFromURI(
-		params.ctx, env, ec.endpoint,
+		params.ctx, p.makeExternalConnEnv(params), ec.endpoint,
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to construct External Connection details")
@@ -159,6 +137,32 @@ func (p *planner) createExternalConnection(
 	return nil
 }
 
+// makeExternalConnEnv returns the environment used to validate the endpoint
+// of an External Connection.
+func (p *planner) makeExternalConnEnv(params runParams) externalconn.ExternalConnEnv {
+	var SkipCheckingExternalStorageConnection bool
+	var SkipCheckingKMSConnection bool
+	if tk := params.ExecCfg().ExternalConnectionTestingKnobs; tk != nil {
+		if tk.SkipCheckingExternalStorageConnection != nil {
+			SkipCheckingExternalStorageConnection = params.ExecCfg().ExternalConnectionTestingKnobs.SkipCheckingExternalStorageConnection()
+		}
+		if tk.SkipCheckingKMSConnection != nil {
+			SkipCheckingKMSConnection = params.ExecCfg().ExternalConnectionTestingKnobs.SkipCheckingKMSConnection()
+		}
+	}
+
+	return externalconn.MakeExternalConnEnv(
+		params.ExecCfg().Settings,
+		&params.ExecCfg().ExternalIODirConfig,
+		params.ExecCfg().InternalDB,
+		p.User(),
+		params.ExecCfg().DistSQLSrv.ExternalStorageFromURI,
+		SkipCheckingExternalStorageConnection,
+		SkipCheckingKMSConnection,
+		&params.ExecCfg().DistSQLSrv.ServerConfig,
+	)
+}
+
 func logAndSanitizeExternalConnectionURI(ctx context.Context, externalConnectionURI string) error {
 	clean, err := cloud.SanitizeExternalStorageURI(externalConnectionURI, nil)
 	if err != nil {
@@ -170,6 +170,9 @@ func TryDelegate(
 	case *tree.ShowFullTableScans:
 		return d.delegateShowFullTableScans()
 
+	case *tree.ShowProtectedTimestamps:
+		return d.delegateShowProtectedTimestamps()
+
 	case *tree.ShowDefaultPrivileges:
 		return d.delegateShowDefaultPrivileges(t)
 
@@ -0,0 +1,37 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package delegate
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+)
+
+// delegateShowProtectedTimestamps lists the protected timestamp records along
+// with the job or schedule that holds each of them, which is useful when
+// diagnosing why MVCC garbage collection is not making progress.
+func (d *delegator) delegateShowProtectedTimestamps() (tree.Statement, error) {
+	sqltelemetry.IncrementShowCounter(sqltelemetry.ProtectedTimestamps)
+	const query = `
+  SELECT
+    r.id,
+    r.ts,
+    r.meta_type AS holder_type,
+    COALESCE((r.decoded_meta->>'jobID')::INT8, (r.decoded_meta->>'scheduleID')::INT8) AS holder_id,
+    j.job_type,
+    COALESCE(j.description, s.schedule_name) AS holder_description,
+    r.decoded_target AS target,
+    r.num_ranges,
+    r.verified,
+    r.last_updated
+  FROM crdb_internal.kv_protected_ts_records AS r
+  LEFT JOIN system.jobs AS j
+    ON r.meta_type = 'jobs' AND j.id = (r.decoded_meta->>'jobID')::INT8
+  LEFT JOIN system.scheduled_jobs AS s
+    ON r.meta_type = 'schedules' AND s.schedule_id = (r.decoded_meta->>'scheduleID')::INT8
+  ORDER BY r.ts`
+	return d.parse(query)
+}
@@ -63,6 +63,13 @@ func (n *discardNode) startExec(params runParams) error {
 			return err
 		}
 
+		// UNLISTEN *
+		notifications, err := params.p.notificationState()
+		if err != nil {
+			return err
+		}
+		notifications.unlistenAll()
+
 	case tree.DiscardModeSequences:
 		params.p.sessionDataMutatorIterator.applyOnEachMutator(func(m sessionDataMutator) {
 			m.data.SequenceState = sessiondata.NewSequenceState()
@@ -75,6 +75,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/optionalnodeliveness"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgnotify"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
@@ -1541,6 +1542,10 @@ type ExecutorConfig struct {
 
 	// LicenseEnforcer is used to enforce the license profiles.
 	LicenseEnforcer *license.Enforcer
+
+	// NotificationRegistry fans out notifications sent with NOTIFY to the
+	// sessions on this SQL instance that are listening on the channel.
+	NotificationRegistry *pgnotify.Registry
 }
 
 // UpdateVersionSystemSettingHook provides a callback that allows us
@@ -2821,7 +2826,7 @@ const (
 	// span_idx    INT NOT NULL,        -- The span's index.
 	traceSpanIdxCol = iota
 	// message_idx INT NOT NULL,        -- The message's index within its span.
-	_
+	traceMsgIdxCol
 	// timestamp   TIMESTAMPTZ NOT NULL,-- The message's timestamp.
 	traceTimestampCol
 	// duration    INTERVAL,            -- The span's duration.
@@ -3761,6 +3766,14 @@ func (m *sessionDataMutator) SetTrigramSimilarityThreshold(val float64) {
 	m.data.TrigramSimilarityThreshold = val
 }
 
+func (m *sessionDataMutator) SetTrigramWordSimilarityThreshold(val float64) {
+	m.data.TrigramWordSimilarityThreshold = val
+}
+
+func (m *sessionDataMutator) SetTrigramStrictWordSimilarityThreshold(val float64) {
+	m.data.TrigramStrictWordSimilarityThreshold = val
+}
+
 func (m *sessionDataMutator) SetUnconstrainedNonCoveringIndexScanEnabled(val bool) {
 	m.data.UnconstrainedNonCoveringIndexScanEnabled = val
 }
@@ -3997,6 +4010,18 @@ func (m *sessionDataMutator) SetLegacyVarcharTyping(val bool) {
 	m.data.LegacyVarcharTyping = val
 }
 
+func (m *sessionDataMutator) SetEstimatedRowsReadErr(val int64) {
+	m.data.EstimatedRowsReadErr = val
+}
+
+func (m *sessionDataMutator) SetEstimatedRowsReadWarn(val int64) {
+	m.data.EstimatedRowsReadWarn = val
+}
+
+func (m *sessionDataMutator) SetOptimizerUseVectorSearch(val bool) {
+	m.data.OptimizerUseVectorSearch = val
+}
+
 // Utility functions related to scrubbing sensitive information on SQL Stats.
 
 // quantizeCounts ensures that the Count field in the
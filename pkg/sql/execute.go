@@ -64,3 +64,29 @@ func (p *planner) fillInPlaceholders(
 		},
 	}, nil
 }
+
+// rewriteExplainExecute helps with the EXPLAIN EXECUTE foo(args) SQL statement:
+// it replaces the EXECUTE inside the EXPLAIN with the referenced prepared
+// statement, and returns the placeholder info for args. The prepared statement
+// is planned as a custom plan for the given arguments.
+func (ex *connExecutor) rewriteExplainExecute(
+	ctx context.Context, stmt *Statement, explain *tree.Explain, execute *tree.Execute,
+) (*tree.PlaceholderInfo, error) {
+	name := execute.Name.String()
+	ps, ok := ex.extraTxnState.prepStmtsNamespace.prepStmts[name]
+	if !ok {
+		return nil, newPreparedStmtDNEError(ex.sessionData(), name)
+	}
+	ex.extraTxnState.prepStmtsNamespace.touchLRUEntry(name)
+
+	pinfo, err := ex.planner.fillInPlaceholders(ctx, ps, name, execute.Params)
+	if err != nil {
+		return nil, err
+	}
+	explainCopy := *explain
+	explainCopy.Statement = ps.AST
+	stmt.AST = &explainCopy
+	stmt.NumPlaceholders = ps.NumPlaceholders
+	stmt.NumAnnotations = ps.NumAnnotations
+	return pinfo, nil
+}
@@ -263,6 +263,11 @@ func (*DummyEvalPlanner) SerializeSessionState() (*tree.DBytes, error) {
 	return nil, errors.WithStack(errEvalPlanner)
 }
 
+// SessionTraceJSON is part of the Planner interface.
+func (*DummyEvalPlanner) SessionTraceJSON() (*tree.DJSON, error) {
+	return nil, errors.WithStack(errEvalPlanner)
+}
+
 // DeserializeSessionState is part of the Planner interface.
 func (*DummyEvalPlanner) DeserializeSessionState(
 	ctx context.Context, token *tree.DBytes,
@@ -572,6 +577,11 @@ func (ep *DummyEvalPlanner) ClearQueryPlanCache() {}
 // ClearTableStatsCache is part of the eval.Planner interface.
 func (ep *DummyEvalPlanner) ClearTableStatsCache() {}
 
+// SendNotification is part of the eval.Planner interface.
+func (ep *DummyEvalPlanner) SendNotification(_ context.Context, _, _ string) error {
+	return errors.WithStack(errEvalPlanner)
+}
+
 // DummyPrivilegedAccessor implements the tree.PrivilegedAccessor interface by returning errors.
 type DummyPrivilegedAccessor struct{}
 
@@ -0,0 +1,167 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package largeobject contains the session-side state of the Postgres
+// large-object compatibility layer. The contents of large objects are stored
+// in the system.large_objects table, split into pages of PageSize bytes; this
+// package keeps track of the large-object descriptors opened by a transaction
+// and of the mapping between byte offsets and pages.
+package largeobject
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/lib/pq/oid"
+)
+
+// PageSize is the number of bytes of a large object stored in each row of
+// system.large_objects. It matches LOBLKSIZE in a default Postgres build.
+const PageSize = 2048
+
+// MaxSize is the maximum size, in bytes, of a large object. It matches the
+// limit imposed by Postgres.
+const MaxSize = PageSize * (1<<31 - 1)
+
+// Access modes accepted by lo_open and lo_creat. The values match INV_WRITE
+// and INV_READ in Postgres' libpq-fs.h.
+const (
+	ModeWrite = 0x00020000
+	ModeRead  = 0x00040000
+)
+
+// Whence values accepted by lo_lseek.
+const (
+	SeekSet = 0
+	SeekCur = 1
+	SeekEnd = 2
+)
+
+// Descriptor is a large object opened with lo_open.
+type Descriptor struct {
+	// OID identifies the large object.
+	OID oid.Oid
+	// Mode is a combination of ModeRead and ModeWrite.
+	Mode int32
+	// Offset is the current read/write position in the large object.
+	Offset int64
+}
+
+// CanRead returns whether the descriptor was opened for reading. As in
+// Postgres, descriptors opened for writing can also be read from.
+func (d *Descriptor) CanRead() bool {
+	return d.Mode&(ModeRead|ModeWrite) != 0
+}
+
+// CanWrite returns whether the descriptor was opened for writing.
+func (d *Descriptor) CanWrite() bool {
+	return d.Mode&ModeWrite != 0
+}
+
+// Seek moves the offset of the descriptor and returns the new offset. size
+// is the current size of the large object and is only used for SeekEnd.
+func (d *Descriptor) Seek(offset int64, whence int32, size int64) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case SeekSet:
+		newOffset = offset
+	case SeekCur:
+		newOffset = d.Offset + offset
+	case SeekEnd:
+		newOffset = size + offset
+	default:
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, "invalid whence setting: %d", whence)
+	}
+	if newOffset < 0 || newOffset > MaxSize {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue,
+			"invalid seek offset: %d", newOffset)
+	}
+	d.Offset = newOffset
+	return newOffset, nil
+}
+
+// Descriptors is the set of large-object descriptors opened by a
+// transaction. As in Postgres, descriptors are only valid until the end of
+// the transaction that opened them.
+type Descriptors struct {
+	// fds is indexed by descriptor number. Closed descriptors are nil, and
+	// their slot is reused by the next call to Open.
+	fds []*Descriptor
+}
+
+// Open registers a new descriptor for the given large object and returns its
+// number.
+func (ds *Descriptors) Open(loid oid.Oid, mode int32) int32 {
+	d := &Descriptor{OID: loid, Mode: mode}
+	for i := range ds.fds {
+		if ds.fds[i] == nil {
+			ds.fds[i] = d
+			return int32(i)
+		}
+	}
+	ds.fds = append(ds.fds, d)
+	return int32(len(ds.fds) - 1)
+}
+
+// Get returns the descriptor with the given number.
+func (ds *Descriptors) Get(fd int32) (*Descriptor, error) {
+	if fd < 0 || int(fd) >= len(ds.fds) || ds.fds[fd] == nil {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, "invalid large-object descriptor: %d", fd)
+	}
+	return ds.fds[fd], nil
+}
+
+// Close closes the descriptor with the given number.
+func (ds *Descriptors) Close(fd int32) error {
+	if _, err := ds.Get(fd); err != nil {
+		return err
+	}
+	ds.fds[fd] = nil
+	return nil
+}
+
+// CloseAll closes all the descriptors that refer to the given large object.
+// It is used when the large object is unlinked.
+func (ds *Descriptors) CloseAll(loid oid.Oid) {
+	for i, d := range ds.fds {
+		if d != nil && d.OID == loid {
+			ds.fds[i] = nil
+		}
+	}
+}
+
+// Reset closes all descriptors. It must be called when the transaction ends.
+func (ds *Descriptors) Reset() {
+	ds.fds = ds.fds[:0]
+}
+
+// Page is the portion of a single page of a large object touched by a read
+// or a write.
+type Page struct {
+	// PageNo is the number of the page.
+	PageNo int32
+	// Start and End are the offsets, relative to the start of the page, of the
+	// bytes that are read or written.
+	Start, End int
+}
+
+// Pages returns the pages spanned by the length bytes starting at offset.
+func Pages(offset, length int64) []Page {
+	if length <= 0 {
+		return nil
+	}
+	end := offset + length
+	pages := make([]Page, 0, (end-1)/PageSize-offset/PageSize+1)
+	for pos := offset; pos < end; {
+		pageNo := pos / PageSize
+		pageStart := pageNo * PageSize
+		p := Page{PageNo: int32(pageNo), Start: int(pos - pageStart), End: PageSize}
+		if end < pageStart+PageSize {
+			p.End = int(end - pageStart)
+		}
+		pages = append(pages, p)
+		pos = pageStart + int64(p.End)
+	}
+	return pages
+}
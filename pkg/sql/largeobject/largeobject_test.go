@@ -0,0 +1,85 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package largeobject
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescriptors(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var ds Descriptors
+	a := ds.Open(100, ModeRead)
+	b := ds.Open(101, ModeWrite)
+	c := ds.Open(100, ModeRead|ModeWrite)
+	require.Equal(t, []int32{0, 1, 2}, []int32{a, b, c})
+
+	d, err := ds.Get(b)
+	require.NoError(t, err)
+	require.True(t, d.CanRead())
+	require.True(t, d.CanWrite())
+	d, err = ds.Get(a)
+	require.NoError(t, err)
+	require.True(t, d.CanRead())
+	require.False(t, d.CanWrite())
+
+	// Closed descriptors are reused.
+	require.NoError(t, ds.Close(b))
+	require.Error(t, ds.Close(b))
+	_, err = ds.Get(b)
+	require.Error(t, err)
+	require.Equal(t, b, ds.Open(102, ModeRead))
+
+	ds.CloseAll(100)
+	_, err = ds.Get(a)
+	require.Error(t, err)
+	_, err = ds.Get(c)
+	require.Error(t, err)
+
+	ds.Reset()
+	_, err = ds.Get(b)
+	require.Error(t, err)
+	require.Equal(t, int32(0), ds.Open(100, ModeRead))
+	_, err = ds.Get(-1)
+	require.Error(t, err)
+}
+
+func TestSeek(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	d := &Descriptor{OID: 100, Mode: ModeRead}
+	off, err := d.Seek(10, SeekSet, 100 /* size */)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), off)
+	off, err = d.Seek(5, SeekCur, 100 /* size */)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), off)
+	off, err = d.Seek(-20, SeekEnd, 100 /* size */)
+	require.NoError(t, err)
+	require.Equal(t, int64(80), off)
+	_, err = d.Seek(-200, SeekCur, 100 /* size */)
+	require.Error(t, err)
+	_, err = d.Seek(0, 3, 100 /* size */)
+	require.Error(t, err)
+	require.Equal(t, int64(80), d.Offset)
+}
+
+func TestPages(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	require.Empty(t, Pages(10, 0))
+	require.Equal(t, []Page{{PageNo: 0, Start: 10, End: 20}}, Pages(10, 10))
+	require.Equal(t, []Page{{PageNo: 1, Start: 0, End: PageSize}}, Pages(PageSize, PageSize))
+	require.Equal(t, []Page{
+		{PageNo: 0, Start: PageSize - 1, End: PageSize},
+		{PageNo: 1, Start: 0, End: PageSize},
+		{PageNo: 2, Start: 0, End: 1},
+	}, Pages(PageSize-1, PageSize+2))
+}
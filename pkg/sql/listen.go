@@ -0,0 +1,27 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// Listen implements the LISTEN statement.
+// See https://www.postgresql.org/docs/current/sql-listen.html for details.
+func (p *planner) Listen(ctx context.Context, n *tree.Listen) (planNode, error) {
+	channel, err := notificationChannelName(n.ChannelName)
+	if err != nil {
+		return nil, err
+	}
+	notifications, err := p.notificationState()
+	if err != nil {
+		return nil, err
+	}
+	notifications.listen(channel)
+	return newZeroNode(nil /* columns */), nil
+}
@@ -1116,6 +1116,13 @@ func TestLogic_json_index(
 	runLogicTest(t, "json_index")
 }
 
+func TestLogic_jsonpath(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "jsonpath")
+}
+
 func TestLogic_kv_builtin_functions(
 	t *testing.T,
 ) {
@@ -1123,6 +1130,13 @@ func TestLogic_kv_builtin_functions(
 	runLogicTest(t, "kv_builtin_functions")
 }
 
+func TestLogic_large_objects(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "large_objects")
+}
+
 func TestLogic_limit(
 	t *testing.T,
 ) {
@@ -1130,6 +1144,13 @@ func TestLogic_limit(
 	runLogicTest(t, "limit")
 }
 
+func TestLogic_listen_notify(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "listen_notify")
+}
+
 func TestLogic_locality(
 	t *testing.T,
 ) {
@@ -2579,6 +2600,13 @@ func TestLogic_workload_indexrecs(
 	runLogicTest(t, "workload_indexrecs")
 }
 
+func TestLogic_xml(
+	t *testing.T,
+) {
+	defer leaktest.AfterTest(t)()
+	runLogicTest(t, "xml")
+}
+
 func TestLogic_zero(
 	t *testing.T,
 ) {
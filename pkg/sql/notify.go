@@ -0,0 +1,252 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgnotify"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+)
+
+// Notify implements the NOTIFY statement.
+// See https://www.postgresql.org/docs/current/sql-notify.html for details.
+func (p *planner) Notify(ctx context.Context, n *tree.Notify) (planNode, error) {
+	channel, err := notificationChannelName(n.ChannelName)
+	if err != nil {
+		return nil, err
+	}
+	var payload string
+	if n.Payload != nil {
+		payload = *n.Payload
+	}
+	if err := p.SendNotification(ctx, channel, payload); err != nil {
+		return nil, err
+	}
+	return newZeroNode(nil /* columns */), nil
+}
+
+// SendNotification is part of the eval.Planner interface. It implements
+// NOTIFY and pg_notify.
+//
+// The notification is written to system.notifications in the current
+// transaction, so it is only delivered, by the rangefeeds of all the SQL
+// instances, if the transaction commits. Before the cluster is upgraded to a
+// version that has the table, notifications are only delivered to the
+// sessions of this SQL instance.
+func (p *planner) SendNotification(ctx context.Context, channel, payload string) error {
+	if channel == "" {
+		return pgerror.New(pgcode.InvalidParameterValue, "channel name cannot be empty")
+	}
+	if len(channel) >= pgnotify.MaxChannelLength {
+		return pgerror.New(pgcode.InvalidParameterValue, "channel name too long")
+	}
+	if len(payload) >= pgnotify.MaxPayloadLength {
+		return pgerror.New(pgcode.InvalidParameterValue, "payload string too long")
+	}
+	notifications, err := p.notificationState()
+	if err != nil {
+		return err
+	}
+	n := pgnotify.Notification{
+		Channel: channel,
+		Payload: payload,
+		PID:     int32(p.EvalContext().QueryCancelKey.GetPGBackendPID()),
+	}
+	persist := p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V25_1_NotificationsTable)
+	if !notifications.notify(n, persist) || !persist {
+		return nil
+	}
+	_, err = p.InternalSQLTxn().ExecEx(
+		ctx, "notify", p.txn, sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.public.notifications (channel, payload, pid) VALUES ($1, $2, $3)`,
+		n.Channel, n.Payload, n.PID,
+	)
+	return err
+}
+
+// notificationState returns the LISTEN/UNLISTEN/NOTIFY state of the
+// session. It returns an error if the planner is not running on behalf of a
+// client session, e.g. in the internal executor.
+func (p *planner) notificationState() (*sessionNotifications, error) {
+	if p.extendedEvalCtx.notifications == nil {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"notifications are not supported in this context")
+	}
+	return p.extendedEvalCtx.notifications, nil
+}
+
+// notificationChannelName returns the name of the channel referenced by a
+// LISTEN, UNLISTEN or NOTIFY statement.
+func notificationChannelName(name *tree.UnresolvedObjectName) (string, error) {
+	if name.NumParts > 1 {
+		return "", pgerror.Newf(pgcode.Syntax,
+			"channel name %s must not be qualified", tree.ErrString(name))
+	}
+	return name.Object(), nil
+}
+
+// listenOp is a LISTEN or UNLISTEN executed in the current transaction.
+type listenOp struct {
+	channel string
+	// unlisten is set for UNLISTEN. If channel is empty, the operation is an
+	// UNLISTEN *.
+	unlisten bool
+}
+
+// sessionNotifications tracks the LISTEN/UNLISTEN/NOTIFY state of a session.
+// As in Postgres, the effects of these statements are deferred until the
+// transaction that executed them commits, and notifications are only
+// delivered to the client in between transactions.
+//
+// Notifications are written to system.notifications by the transaction that
+// sends them and fanned out to the listening sessions of every SQL instance
+// by their pgnotify.Registry.
+type sessionNotifications struct {
+	registry *pgnotify.Registry
+	// onNotify, if set, is passed to the session's pgnotify.Subscription.
+	onNotify func()
+
+	// sub is created lazily the first time the session starts listening on a
+	// channel.
+	sub *pgnotify.Subscription
+
+	// txn contains the operations of the current transaction.
+	txn struct {
+		ops           []listenOp
+		notifications []pendingNotification
+	}
+}
+
+// pendingNotification is a notification sent by the current transaction.
+type pendingNotification struct {
+	pgnotify.Notification
+	// persisted is set if the notification was written to
+	// system.notifications, in which case it is delivered by the registry's
+	// rangefeed rather than directly when the transaction commits.
+	persisted bool
+}
+
+// notificationsSavepoint is the state of the current transaction's
+// notifications at the time a savepoint was created.
+type notificationsSavepoint struct {
+	numOps           int
+	numNotifications int
+}
+
+func (s *sessionNotifications) listen(channel string) {
+	s.txn.ops = append(s.txn.ops, listenOp{channel: channel})
+}
+
+func (s *sessionNotifications) unlisten(channel string) {
+	s.txn.ops = append(s.txn.ops, listenOp{channel: channel, unlisten: true})
+}
+
+func (s *sessionNotifications) unlistenAll() {
+	s.unlisten("" /* channel */)
+}
+
+// notify queues a notification to be sent when the transaction commits. As
+// in Postgres, identical notifications sent in the same transaction are folded
+// into one; notify returns false if n is a duplicate.
+func (s *sessionNotifications) notify(n pgnotify.Notification, persisted bool) bool {
+	for _, pending := range s.txn.notifications {
+		if pending.Notification == n {
+			return false
+		}
+	}
+	s.txn.notifications = append(s.txn.notifications, pendingNotification{
+		Notification: n,
+		persisted:    persisted,
+	})
+	return true
+}
+
+// hasPendingOps returns whether the current transaction executed LISTEN,
+// UNLISTEN or NOTIFY.
+func (s *sessionNotifications) hasPendingOps() bool {
+	return len(s.txn.ops) > 0 || len(s.txn.notifications) > 0
+}
+
+// savepoint returns the current state of the transaction, to be restored by
+// rollbackToSavepoint.
+func (s *sessionNotifications) savepoint() notificationsSavepoint {
+	return notificationsSavepoint{
+		numOps:           len(s.txn.ops),
+		numNotifications: len(s.txn.notifications),
+	}
+}
+
+// rollbackToSavepoint discards the operations executed since the savepoint was
+// created. The rows written to system.notifications in the meantime are
+// discarded by the KV savepoint rollback.
+func (s *sessionNotifications) rollbackToSavepoint(sp notificationsSavepoint) {
+	s.txn.ops = s.txn.ops[:sp.numOps]
+	s.txn.notifications = s.txn.notifications[:sp.numNotifications]
+}
+
+// commit applies the operations of the transaction that just committed.
+func (s *sessionNotifications) commit() {
+	if s.registry == nil {
+		s.rollback()
+		return
+	}
+	for _, op := range s.txn.ops {
+		switch {
+		case !op.unlisten:
+			if s.sub == nil {
+				s.sub = s.registry.Subscribe(s.onNotify)
+			}
+			s.sub.Listen(op.channel)
+		case s.sub == nil:
+			// Not listening on anything.
+		case op.channel == "":
+			s.sub.UnlistenAll()
+		default:
+			s.sub.Unlisten(op.channel)
+		}
+	}
+	for _, n := range s.txn.notifications {
+		if !n.persisted {
+			s.registry.Notify(n.Notification)
+		}
+	}
+	s.rollback()
+}
+
+// rollback discards the operations of the current transaction.
+func (s *sessionNotifications) rollback() {
+	s.txn.ops = s.txn.ops[:0]
+	s.txn.notifications = s.txn.notifications[:0]
+}
+
+// deliver buffers the notifications received by the session into res.
+func (s *sessionNotifications) deliver(ctx context.Context, res NotificationBuffer) {
+	if s.sub == nil {
+		return
+	}
+	pending, dropped := s.sub.Drain()
+	if dropped > 0 {
+		log.Warningf(ctx, "dropped %d notifications because the session's queue was full", dropped)
+	}
+	for _, n := range pending {
+		res.BufferNotification(n)
+	}
+}
+
+// close stops listening on all channels.
+func (s *sessionNotifications) close() {
+	s.rollback()
+	if s.sub != nil {
+		s.sub.Close()
+		s.sub = nil
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgnotify"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionNotificationsSavepoints(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	registry := pgnotify.NewRegistry()
+	listener := registry.Subscribe(nil /* onNotify */)
+	defer listener.Close()
+	listener.Listen("foo")
+
+	s := sessionNotifications{registry: registry}
+	defer s.close()
+
+	s.listen("a")
+	require.True(t, s.notify(pgnotify.Notification{Channel: "foo", Payload: "1"}, false /* persisted */))
+	sp := s.savepoint()
+	s.listen("b")
+	s.unlistenAll()
+	require.True(t, s.notify(pgnotify.Notification{Channel: "foo", Payload: "2"}, false /* persisted */))
+	// Identical notifications are folded.
+	require.False(t, s.notify(pgnotify.Notification{Channel: "foo", Payload: "1"}, false /* persisted */))
+	require.True(t, s.hasPendingOps())
+
+	// Rolling back to the savepoint discards the LISTEN, UNLISTEN and NOTIFY
+	// executed after it.
+	s.rollbackToSavepoint(sp)
+	require.True(t, s.hasPendingOps())
+	s.commit()
+	require.False(t, s.hasPendingOps())
+	require.Equal(t, []string{"a"}, s.sub.Channels())
+	pending, _ := listener.Drain()
+	require.Equal(t, []pgnotify.Notification{{Channel: "foo", Payload: "1"}}, pending)
+
+	// Notifications written to system.notifications are delivered by the
+	// registry's rangefeed, not when the transaction commits.
+	require.True(t, s.notify(pgnotify.Notification{Channel: "foo", Payload: "3"}, true /* persisted */))
+	s.commit()
+	pending, _ = listener.Drain()
+	require.Empty(t, pending)
+
+	// Notifications of rolled back transactions are discarded.
+	s.unlisten("a")
+	require.True(t, s.notify(pgnotify.Notification{Channel: "foo", Payload: "4"}, false /* persisted */))
+	s.rollback()
+	require.False(t, s.hasPendingOps())
+	require.Equal(t, []string{"a"}, s.sub.Channels())
+	pending, _ = listener.Drain()
+	require.Empty(t, pending)
+}
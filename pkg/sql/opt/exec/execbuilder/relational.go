@@ -255,6 +255,9 @@ func (b *Builder) buildRelational(e memo.RelExpr) (_ execPlan, outputCols colOrd
 	case *memo.InvertedFilterExpr:
 		ep, outputCols, err = b.buildInvertedFilter(t)
 
+	case *memo.VectorSearchExpr:
+		ep, outputCols, err = b.buildVectorSearch(t)
+
 	case *memo.UpdateExpr:
 		ep, outputCols, err = b.buildUpdate(t)
 
@@ -967,6 +970,15 @@ func (b *Builder) buildSelect(sel *memo.SelectExpr) (_ execPlan, outputCols colO
 	return res, inputCols, nil
 }
 
+func (b *Builder) buildVectorSearch(
+	search *memo.VectorSearchExpr,
+) (_ execPlan, outputCols colOrdMap, err error) {
+	return execPlan{}, colOrdMap{}, unimplemented.NewWithIssuef(137370,
+		"vector search on index %s is not yet supported",
+		b.mem.Metadata().Table(search.Table).Index(search.Index).Name(),
+	)
+}
+
 func (b *Builder) buildInvertedFilter(
 	invFilter *memo.InvertedFilterExpr,
 ) (_ execPlan, outputCols colOrdMap, err error) {
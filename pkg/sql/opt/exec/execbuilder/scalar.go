@@ -64,6 +64,7 @@ func init() {
 		opt.AnyOp:            (*Builder).buildAny,
 		opt.AnyScalarOp:      (*Builder).buildAnyScalar,
 		opt.IndirectionOp:    (*Builder).buildIndirection,
+		opt.ArraySliceOp:     (*Builder).buildArraySlice,
 		opt.CollateOp:        (*Builder).buildCollate,
 		opt.ArrayFlattenOp:   (*Builder).buildArrayFlatten,
 		opt.IfErrOp:          (*Builder).buildIfErr,
@@ -493,6 +494,32 @@ func (b *Builder) buildIndirection(
 	return tree.NewTypedIndirectionExpr(expr, index, scalar.DataType()), nil
 }
 
+func (b *Builder) buildArraySlice(
+	ctx *buildScalarCtx, scalar opt.ScalarExpr,
+) (tree.TypedExpr, error) {
+	slice := scalar.(*memo.ArraySliceExpr)
+	expr, err := b.buildScalar(ctx, slice.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	var begin, end tree.TypedExpr
+	if slice.Begin.ChildCount() > 0 {
+		begin, err = b.buildScalar(ctx, slice.Begin.Child(0).(opt.ScalarExpr))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if slice.End.ChildCount() > 0 {
+		end, err = b.buildScalar(ctx, slice.End.Child(0).(opt.ScalarExpr))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tree.NewTypedArraySliceExpr(expr, begin, end, scalar.DataType()), nil
+}
+
 func (b *Builder) buildCollate(ctx *buildScalarCtx, scalar opt.ScalarExpr) (tree.TypedExpr, error) {
 	expr, err := b.buildScalar(ctx, scalar.Child(0).(opt.ScalarExpr))
 	if err != nil {
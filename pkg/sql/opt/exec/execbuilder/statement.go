@@ -132,6 +132,11 @@ func (b *Builder) buildExplainOpt(
 		planText.WriteString(memoStr)
 	}
 
+	// If STATS option was passed, show the planning statistics.
+	if explain.Options.Flags[tree.ExplainFlagStats] {
+		planText.WriteString(b.optimizer.FormatPlanningStats())
+	}
+
 	f := memo.MakeExprFmtCtx(b.ctx, fmtFlags, redactValues, b.mem, b.catalog)
 	f.FormatExpr(explain.Input)
 	planStr := f.Buffer.String()
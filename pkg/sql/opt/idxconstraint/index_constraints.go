@@ -1187,6 +1187,16 @@ func (ic *Instance) UnconsolidatedConstraint(c *constraint.Constraint) {
 	*c = ic.constraint
 }
 
+// Tight returns true if the constraint created by Init exactly represents the
+// filters, in which case there are no remaining filters. Panics if Init wasn't
+// called.
+func (ic *Instance) Tight() bool {
+	if !ic.initialized {
+		panic(errors.AssertionFailedf("Init was not called"))
+	}
+	return ic.tight
+}
+
 // RemainingFilters calculates a simplified FiltersExpr that needs to be applied
 // within the returned Spans.
 func (ic *Instance) RemainingFilters() memo.FiltersExpr {
@@ -80,6 +80,27 @@ func (t *trigramFilterPlanner) extractInvertedFilterConditionFromLeaf(
 		allMustMatch = false
 		// Similarity is commutative.
 		commutative = true
+	case *memo.WordSimilarExpr, *memo.WordSimilarCommutedExpr:
+		// A string only has a positive word similarity with another string if
+		// they share a trigram, so, like for %, we construct an OR out of the
+		// spans and filter the results further afterwards. With a threshold of
+		// zero, every string matches and the index cannot be used.
+		if evalCtx.SessionData().TrigramWordSimilarityThreshold <= 0 {
+			return inverted.NonInvertedColExpression{}, expr, nil
+		}
+		left, right = expr.Child(0).(opt.ScalarExpr), expr.Child(1).(opt.ScalarExpr)
+		allMustMatch = false
+		// Word similarity is not commutative, but the indexed column can be
+		// either operand since both must share a trigram.
+		commutative = true
+	case *memo.StrictWordSimilarExpr, *memo.StrictWordSimilarCommutedExpr:
+		// See the comment for WordSimilarExpr above.
+		if evalCtx.SessionData().TrigramStrictWordSimilarityThreshold <= 0 {
+			return inverted.NonInvertedColExpression{}, expr, nil
+		}
+		left, right = expr.Child(0).(opt.ScalarExpr), expr.Child(1).(opt.ScalarExpr)
+		allMustMatch = false
+		commutative = true
 	default:
 		// Only the above types are supported.
 		return inverted.NonInvertedColExpression{}, expr, nil
@@ -36,6 +36,8 @@ func TestTryFilterTrigram(t *testing.T) {
 	tn := tree.NewUnqualifiedTableName("t")
 	tab := md.AddTable(tc.Table(tn), tn)
 	trigramOrd := 1
+	evalCtx.SessionData().TrigramWordSimilarityThreshold = 0.6
+	evalCtx.SessionData().TrigramStrictWordSimilarityThreshold = 0.5
 
 	// If we can create an inverted filter with the given filter expression and
 	// index, ok=true. If the spans in the resulting inverted index constraint
@@ -87,6 +89,14 @@ func TestTryFilterTrigram(t *testing.T) {
 		{filters: "s % 'lkj' AND s LIKE 'blort'", ok: true, unique: false},
 		{filters: "s % 'lkj' OR s LIKE 'blort'", ok: true, unique: false},
 
+		// Word similarity queries. The indexed column can be either operand.
+		{filters: "'lkj' <% s", ok: true, unique: false},
+		{filters: "s %> 'lkj'", ok: true, unique: false},
+		{filters: "s <% 'lkj'", ok: true, unique: false},
+		{filters: "'lkj' <<% s", ok: true, unique: false},
+		{filters: "s %>> 'lkj'", ok: true, unique: false},
+		{filters: "'lkj' <% s AND s LIKE '%blort%'", ok: true, unique: false},
+
 		// Equality queries.
 		{filters: "s = 'lkjsdlkj'", ok: true, unique: false},
 		{filters: "s = 'lkj'", ok: true, unique: true},
@@ -129,4 +139,21 @@ func TestTryFilterTrigram(t *testing.T) {
 		require.Equal(t, filters.String(), remainingFilters.String(),
 			"mismatched remaining filters")
 	}
+
+	// Every string has a word similarity of at least zero with the constant, so
+	// the index cannot be used with a zero threshold.
+	evalCtx.SessionData().TrigramWordSimilarityThreshold = 0
+	filters := testutils.BuildFilters(t, &f, &semaCtx, evalCtx, "'lkj' <% s")
+	_, _, _, _, ok := invertedidx.TryFilterInvertedIndex(
+		context.Background(),
+		evalCtx,
+		&f,
+		filters,
+		nil, /* optionalFilters */
+		tab,
+		md.Table(tab).Index(trigramOrd),
+		nil,       /* computedColumns */
+		func() {}, /* checkCancellation */
+	)
+	require.False(t, ok, "expected no inverted filter with a zero threshold")
 }
@@ -114,6 +114,19 @@ func (m *Memo) CheckExpr(e opt.Expr) {
 	case *SelectExpr:
 		checkFilters(t.Filters)
 
+	case *VectorSearchExpr:
+		if !m.Metadata().Table(t.Table).Index(t.Index).IsVector() {
+			panic(errors.AssertionFailedf("vector search on a non-vector index"))
+		}
+		switch t.QueryVector.Op() {
+		case opt.ConstOp, opt.PlaceholderOp:
+		default:
+			panic(errors.AssertionFailedf(
+				"vector search query vector must be a constant or placeholder, found %s",
+				redact.Safe(t.QueryVector.Op()),
+			))
+		}
+
 	case *UnionExpr, *UnionAllExpr, *LocalityOptimizedSearchExpr:
 		setPrivate := t.Private().(*SetPrivate)
 		outColSet := setPrivate.OutCols.ToSet()
@@ -405,6 +405,50 @@ func (b *logicalPropsBuilder) buildInvertedFilterProps(
 	}
 }
 
+func (b *logicalPropsBuilder) buildVectorSearchProps(
+	search *VectorSearchExpr, rel *props.Relational,
+) {
+	BuildSharedProps(search, &rel.Shared, b.evalCtx)
+
+	md := search.Memo().Metadata()
+
+	// Output Columns
+	// --------------
+	// The search returns the primary key columns stored in the definition.
+	rel.OutputCols = search.Cols
+
+	// Not Null Columns
+	// ----------------
+	// Primary key columns are never NULL.
+	rel.NotNullCols = makeTableNotNullCols(md, search.Table).Intersection(rel.OutputCols)
+
+	// Outer Columns
+	// -------------
+	// Outer columns were derived by BuildSharedProps. The query vector is a
+	// constant or placeholder, so there should be none.
+
+	// Functional Dependencies
+	// -----------------------
+	// The search returns each row at most once, so the primary key columns
+	// form a key.
+	rel.FuncDeps.AddStrictKey(search.Cols, rel.OutputCols)
+	rel.FuncDeps.MakeNotNull(rel.NotNullCols)
+
+	// Cardinality
+	// -----------
+	// The search returns at most TargetNeighborCount rows.
+	rel.Cardinality = props.AnyCardinality
+	if search.TargetNeighborCount > 0 && search.TargetNeighborCount < math.MaxUint32 {
+		rel.Cardinality = rel.Cardinality.Limit(uint32(search.TargetNeighborCount))
+	}
+
+	// Statistics
+	// ----------
+	if !b.disableStats {
+		b.sb.buildVectorSearch(search, rel)
+	}
+}
+
 func (b *logicalPropsBuilder) buildInnerJoinProps(join *InnerJoinExpr, rel *props.Relational) {
 	b.buildJoinProps(join, rel)
 }
@@ -485,6 +485,16 @@ func TestMemoIsStale(t *testing.T) {
 	stale()
 	evalCtx.SessionData().TrigramSimilarityThreshold = 0
 
+	// Stale pg_trgm.word_similarity_threshold.
+	evalCtx.SessionData().TrigramWordSimilarityThreshold = 0.5
+	stale()
+	evalCtx.SessionData().TrigramWordSimilarityThreshold = 0
+
+	// Stale pg_trgm.strict_word_similarity_threshold.
+	evalCtx.SessionData().TrigramStrictWordSimilarityThreshold = 0.5
+	stale()
+	evalCtx.SessionData().TrigramStrictWordSimilarityThreshold = 0
+
 	// Stale opt_split_scan_limit.
 	evalCtx.SessionData().OptSplitScanLimit = 100
 	stale()
@@ -538,6 +548,12 @@ func TestMemoIsStale(t *testing.T) {
 	evalCtx.SessionData().LegacyVarcharTyping = false
 	notStale()
 
+	// Stale optimizer_use_vector_search.
+	evalCtx.SessionData().OptimizerUseVectorSearch = true
+	stale()
+	evalCtx.SessionData().OptimizerUseVectorSearch = false
+	notStale()
+
 	// User no longer has access to view.
 	catalog.View(tree.NewTableNameWithSchema("t", catconstants.PublicSchemaName, "abcview")).Revoked = true
 	_, err = o.Memo().IsStale(ctx, &evalCtx, catalog)
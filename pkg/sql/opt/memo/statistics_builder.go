@@ -442,6 +442,9 @@ func (sb *statisticsBuilder) colStat(colSet opt.ColSet, e RelExpr) *props.Column
 	case opt.InvertedFilterOp:
 		return sb.colStatInvertedFilter(colSet, e.(*InvertedFilterExpr))
 
+	case opt.VectorSearchOp:
+		return sb.colStatVectorSearch(colSet, e.(*VectorSearchExpr))
+
 	case opt.ValuesOp:
 		return sb.colStatValues(colSet, e.(*ValuesExpr))
 
@@ -1269,6 +1272,51 @@ func (sb *statisticsBuilder) colStatInvertedFilter(
 	return colStat
 }
 
+// +---------------+
+// | Vector Search |
+// +---------------+
+
+func (sb *statisticsBuilder) buildVectorSearch(
+	search *VectorSearchExpr, relProps *props.Relational,
+) {
+	s := relProps.Statistics()
+	if zeroCardinality := s.Init(relProps); zeroCardinality {
+		// Short cut if cardinality is 0.
+		return
+	}
+	tableStats := sb.makeTableStatistics(search.Table)
+	s.Available = tableStats.Available
+
+	// The search returns up to TargetNeighborCount rows from the table.
+	s.RowCount = tableStats.RowCount
+	s.VirtualCols.UnionWith(tableStats.VirtualCols)
+	if tableStats.RowCount > 0 && search.TargetNeighborCount > 0 {
+		s.RowCount = min(float64(search.TargetNeighborCount), tableStats.RowCount)
+		s.Selectivity = props.MakeSelectivity(s.RowCount / tableStats.RowCount)
+	}
+
+	sb.finalizeFromCardinality(relProps)
+}
+
+func (sb *statisticsBuilder) colStatVectorSearch(
+	colSet opt.ColSet, search *VectorSearchExpr,
+) *props.ColumnStatistic {
+	relProps := search.Relational()
+	s := relProps.Statistics()
+	colStat := sb.copyColStat(colSet, s, sb.colStatTable(search.Table, colSet))
+
+	if s.Selectivity != props.OneSelectivity {
+		tableStats := sb.makeTableStatistics(search.Table)
+		colStat.ApplySelectivity(s.Selectivity, tableStats.RowCount)
+	}
+
+	if colSet.Intersects(relProps.NotNullCols) {
+		colStat.NullCount = 0
+	}
+	sb.finalizeFromRowCountAndDistinctCounts(colStat, s)
+	return colStat
+}
+
 // +------+
 // | Join |
 // +------+
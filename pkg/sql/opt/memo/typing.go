@@ -183,6 +183,7 @@ func init() {
 	typingFuncMap[opt.SubqueryOp] = typeSubquery
 	typingFuncMap[opt.ColumnAccessOp] = typeColumnAccess
 	typingFuncMap[opt.IndirectionOp] = typeIndirection
+	typingFuncMap[opt.ArraySliceOp] = typeArraySlice
 	typingFuncMap[opt.CollateOp] = typeCollate
 	typingFuncMap[opt.ArrayFlattenOp] = typeArrayFlatten
 	typingFuncMap[opt.IfErrOp] = typeIfErr
@@ -270,6 +271,13 @@ func typeIndirection(e opt.ScalarExpr) *types.T {
 	}
 }
 
+// typeArraySlice returns the type of the array after the slice is applied.
+// Slicing an int2vector or oidvector produces a regular array.
+func typeArraySlice(e opt.ScalarExpr) *types.T {
+	t := e.Child(0).(opt.ScalarExpr).DataType()
+	return types.MakeArray(t.ArrayContents())
+}
+
 // typeCollate returns the collated string typed with the given locale.
 func typeCollate(e opt.ScalarExpr) *types.T {
 	t := e.Child(0).(opt.ScalarExpr).DataType()
@@ -514,6 +522,14 @@ func NormalizeComparison(op opt.Operator) (newOp opt.Operator, flipped, not bool
 	case opt.IsNotOp:
 		// IsNot(left, right) is implemented as !Is(left, right)
 		return opt.IsOp, false, true
+	case opt.WordSimilarCommutedOp:
+		// WordSimilarCommuted(left, right) is implemented as
+		// WordSimilar(right, left)
+		return opt.WordSimilarOp, true, false
+	case opt.StrictWordSimilarCommutedOp:
+		// StrictWordSimilarCommuted(left, right) is implemented as
+		// StrictWordSimilar(right, left)
+		return opt.StrictWordSimilarOp, true, false
 	}
 	return op, false, false
 }
@@ -112,35 +112,39 @@ var ComparisonOpMap [treecmp.NumComparisonOperatorSymbols]Operator
 // ComparisonOpReverseMap maps from an optimizer operator type to a semantic
 // tree comparison operator type.
 var ComparisonOpReverseMap = map[Operator]treecmp.ComparisonOperatorSymbol{
-	EqOp:             treecmp.EQ,
-	LtOp:             treecmp.LT,
-	GtOp:             treecmp.GT,
-	LeOp:             treecmp.LE,
-	GeOp:             treecmp.GE,
-	NeOp:             treecmp.NE,
-	InOp:             treecmp.In,
-	NotInOp:          treecmp.NotIn,
-	LikeOp:           treecmp.Like,
-	NotLikeOp:        treecmp.NotLike,
-	ILikeOp:          treecmp.ILike,
-	NotILikeOp:       treecmp.NotILike,
-	SimilarToOp:      treecmp.SimilarTo,
-	NotSimilarToOp:   treecmp.NotSimilarTo,
-	RegMatchOp:       treecmp.RegMatch,
-	NotRegMatchOp:    treecmp.NotRegMatch,
-	RegIMatchOp:      treecmp.RegIMatch,
-	NotRegIMatchOp:   treecmp.NotRegIMatch,
-	IsOp:             treecmp.IsNotDistinctFrom,
-	IsNotOp:          treecmp.IsDistinctFrom,
-	ContainsOp:       treecmp.Contains,
-	ContainedByOp:    treecmp.ContainedBy,
-	JsonExistsOp:     treecmp.JSONExists,
-	JsonSomeExistsOp: treecmp.JSONSomeExists,
-	JsonAllExistsOp:  treecmp.JSONAllExists,
-	OverlapsOp:       treecmp.Overlaps,
-	BBoxCoversOp:     treecmp.RegMatch,
-	BBoxIntersectsOp: treecmp.Overlaps,
-	TSMatchesOp:      treecmp.TSMatches,
+	EqOp:                        treecmp.EQ,
+	LtOp:                        treecmp.LT,
+	GtOp:                        treecmp.GT,
+	LeOp:                        treecmp.LE,
+	GeOp:                        treecmp.GE,
+	NeOp:                        treecmp.NE,
+	InOp:                        treecmp.In,
+	NotInOp:                     treecmp.NotIn,
+	LikeOp:                      treecmp.Like,
+	NotLikeOp:                   treecmp.NotLike,
+	ILikeOp:                     treecmp.ILike,
+	NotILikeOp:                  treecmp.NotILike,
+	SimilarToOp:                 treecmp.SimilarTo,
+	NotSimilarToOp:              treecmp.NotSimilarTo,
+	RegMatchOp:                  treecmp.RegMatch,
+	NotRegMatchOp:               treecmp.NotRegMatch,
+	RegIMatchOp:                 treecmp.RegIMatch,
+	NotRegIMatchOp:              treecmp.NotRegIMatch,
+	IsOp:                        treecmp.IsNotDistinctFrom,
+	IsNotOp:                     treecmp.IsDistinctFrom,
+	ContainsOp:                  treecmp.Contains,
+	ContainedByOp:               treecmp.ContainedBy,
+	JsonExistsOp:                treecmp.JSONExists,
+	JsonSomeExistsOp:            treecmp.JSONSomeExists,
+	JsonAllExistsOp:             treecmp.JSONAllExists,
+	OverlapsOp:                  treecmp.Overlaps,
+	BBoxCoversOp:                treecmp.RegMatch,
+	BBoxIntersectsOp:            treecmp.Overlaps,
+	TSMatchesOp:                 treecmp.TSMatches,
+	WordSimilarOp:               treecmp.WordSimilar,
+	WordSimilarCommutedOp:       treecmp.WordSimilarCommuted,
+	StrictWordSimilarOp:         treecmp.StrictWordSimilar,
+	StrictWordSimilarCommutedOp: treecmp.StrictWordSimilarCommuted,
 }
 
 // BinaryOpReverseMap maps from an optimizer operator type to a semantic tree
@@ -282,7 +286,8 @@ func ScalarOperatorTransmitsNulls(op Operator) bool {
 	case BitandOp, BitorOp, BitxorOp, PlusOp, MinusOp, MultOp, DivOp, FloorDivOp,
 		ModOp, PowOp, EqOp, NeOp, LtOp, GtOp, LeOp, GeOp, LikeOp, NotLikeOp, ILikeOp,
 		NotILikeOp, SimilarToOp, NotSimilarToOp, RegMatchOp, NotRegMatchOp, RegIMatchOp,
-		NotRegIMatchOp, ConstOp, BBoxCoversOp, BBoxIntersectsOp:
+		NotRegIMatchOp, ConstOp, BBoxCoversOp, BBoxIntersectsOp, WordSimilarOp,
+		WordSimilarCommutedOp, StrictWordSimilarOp, StrictWordSimilarCommutedOp:
 		return true
 
 	default:
@@ -298,7 +303,8 @@ func BoolOperatorRequiresNotNullArgs(op Operator) bool {
 		EqOp, LtOp, LeOp, GtOp, GeOp, NeOp,
 		LikeOp, NotLikeOp, ILikeOp, NotILikeOp, SimilarToOp, NotSimilarToOp,
 		RegMatchOp, NotRegMatchOp, RegIMatchOp, NotRegIMatchOp, BBoxCoversOp,
-		BBoxIntersectsOp:
+		BBoxIntersectsOp, WordSimilarOp, WordSimilarCommutedOp, StrictWordSimilarOp,
+		StrictWordSimilarCommutedOp:
 		return true
 	}
 	return false
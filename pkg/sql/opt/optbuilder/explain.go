@@ -19,7 +19,7 @@ import (
 func (b *Builder) buildExplain(explain *tree.Explain, inScope *scope) (outScope *scope) {
 	if _, ok := explain.Statement.(*tree.Execute); ok {
 		panic(pgerror.New(
-			pgcode.FeatureNotSupported, "EXPLAIN EXECUTE is not supported; use EXPLAIN ANALYZE",
+			pgcode.FeatureNotSupported, "EXPLAIN EXECUTE is only supported as a top-level statement",
 		))
 	}
 
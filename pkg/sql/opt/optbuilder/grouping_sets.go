@@ -0,0 +1,529 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package optbuilder
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+)
+
+// This file has builder code specific to GROUPING SETS, ROLLUP and CUBE.
+//
+// A GROUP BY clause with grouping sets is built as one plain GROUP BY per
+// grouping set, and the results are combined with UNION ALL. For example:
+//
+//   SELECT a, b, sum(c), GROUPING(a, b) FROM t GROUP BY ROLLUP (a, b)
+//
+// is built as:
+//
+//   WITH input AS (SELECT * FROM t)
+//   SELECT a, b, sum(c), 0 AS grouping FROM input GROUP BY a, b
+//   UNION ALL
+//   SELECT a, NULL AS b, sum(c), 1 AS grouping FROM input GROUP BY a
+//   UNION ALL
+//   SELECT NULL AS a, NULL AS b, sum(c), 3 AS grouping FROM input HAVING true
+//
+// In each branch, references to grouping expressions that are not part of the
+// branch's grouping set are replaced with NULL (outside of aggregate function
+// arguments), and GROUPING(...) is replaced with a constant bit mask. The empty
+// grouping set is built as a scalar aggregation, which is forced with HAVING
+// true when the query has no HAVING clause of its own. Since each branch is an
+// ordinary aggregation, the union distributes like any other set operation.
+//
+// The FROM and WHERE clauses are built only once, as the binding of a With
+// expression that every branch scans. The binding is inlined into each branch
+// unless it is volatile (see CanInlineWith), so volatile expressions in the
+// input are evaluated once for all grouping sets.
+
+// maxGroupingSets is the maximum number of grouping sets a GROUP BY clause can
+// expand to. It matches the limit in Postgres.
+const maxGroupingSets = 4096
+
+// groupingSet is the list of expressions grouped by a single grouping set.
+type groupingSet []tree.Expr
+
+// buildGroupingSets builds a SELECT clause that uses GROUPING SETS, ROLLUP,
+// CUBE or GROUPING(...). If the GROUP BY clause expands to multiple grouping
+// sets, the result is a union with one branch per grouping set, and the
+// returned ORDER BY clause must be applied to the result of the union.
+// Otherwise, the result is a single SELECT clause built with the ORDER BY
+// clause, and the returned ORDER BY clause has its GROUPING(...) expressions
+// replaced. buildGroupingSets returns ok=false without building anything if
+// the clause needs no rewriting.
+//
+// See Builder.buildStmt for a description of the remaining input and
+// return values.
+func (b *Builder) buildGroupingSets(
+	sel *tree.SelectClause,
+	orderBy tree.OrderBy,
+	lockCtx lockingContext,
+	desiredTypes []*types.T,
+	inScope *scope,
+) (outScope *scope, newOrderBy tree.OrderBy, ok bool) {
+	if len(sel.GroupBy) == 0 {
+		return nil, orderBy, false
+	}
+	hasGroupingSets := false
+	for _, e := range sel.GroupBy {
+		if _, ok := e.(*tree.GroupingSet); ok {
+			hasGroupingSets = true
+			break
+		}
+	}
+	if !hasGroupingSets && !containsGroupingExpr(sel, orderBy) {
+		return nil, orderBy, false
+	}
+
+	// The input is shared by all grouping sets. Grouping expressions are
+	// matched by the columns they refer to in the input, so that, for example,
+	// "t.a" and "a" are the same grouping expression.
+	if sel.Where != nil {
+		lockCtx.safeUpdate = true
+	}
+	fromScope := b.buildFrom(sel.From, lockCtx, inScope)
+	b.processWindowDefs(sel, fromScope)
+	b.buildWhere(sel.Where, fromScope)
+
+	// The grouping sets of the GROUP BY clause are the cross product of the
+	// grouping sets of each of its items.
+	sets := []groupingSet{nil}
+	for _, e := range sel.GroupBy {
+		itemSets := groupingSetsOf(fromScope, sel, e)
+		if len(sets)*len(itemSets) > maxGroupingSets {
+			panic(errTooManyGroupingSets)
+		}
+		product := make([]groupingSet, 0, len(sets)*len(itemSets))
+		for _, s := range sets {
+			for _, is := range itemSets {
+				product = append(product, combineGroupingSets(fromScope, s, is))
+			}
+		}
+		sets = product
+	}
+
+	all := make(map[string]struct{})
+	for _, s := range sets {
+		for _, e := range s {
+			all[groupingExprKey(fromScope, e)] = struct{}{}
+		}
+	}
+
+	if len(sets) == 1 {
+		r := groupingSetReplacer{b: b, fromScope: fromScope, all: all, grouped: all}
+		branch := r.replaceSelectClause(sel, sets[0], false /* aliasTargets */)
+		newOrderBy = make(tree.OrderBy, len(orderBy))
+		for i := range orderBy {
+			order := *orderBy[i]
+			if order.Expr != nil {
+				order.Expr = r.replace(order.Expr)
+			}
+			newOrderBy[i] = &order
+		}
+		outScope = b.buildSelectClauseWithFromScope(branch, newOrderBy, lockCtx, desiredTypes, fromScope)
+		return outScope, newOrderBy, true
+	}
+
+	b.rejectIfLocking(lockCtx.locking, "GROUPING SETS")
+	if len(sel.DistinctOn) > 0 {
+		panic(unimplemented.NewWithIssue(46280, "DISTINCT ON with grouping sets"))
+	}
+	if len(sel.Window) > 0 {
+		panic(unimplemented.NewWithIssue(46280, "window functions with grouping sets"))
+	}
+
+	withID := b.factory.Memo().NextWithID()
+	b.factory.Metadata().AddWithBinding(withID, fromScope.expr)
+
+	for _, s := range sets {
+		grouped := make(map[string]struct{}, len(s))
+		for _, e := range s {
+			grouped[groupingExprKey(fromScope, e)] = struct{}{}
+		}
+		r := groupingSetReplacer{
+			b: b, fromScope: fromScope, all: all, grouped: grouped, rejectWindows: true,
+		}
+		branch := r.replaceSelectClause(sel, s, true /* aliasTargets */)
+		branchScope := b.buildSelectClauseWithFromScope(
+			branch, nil /* orderBy */, lockCtx, desiredTypes,
+			b.buildGroupingSetsInput(withID, fromScope, inScope),
+		)
+		if outScope == nil {
+			outScope = branchScope
+			// Try to propagate types from the first branch to the others, if we
+			// didn't already have desired types.
+			if len(desiredTypes) == 0 {
+				desiredTypes = branchScope.makeColumnTypes()
+			}
+			continue
+		}
+		// DISTINCT must remove duplicates across grouping sets too.
+		outScope = b.buildSetOp(tree.UnionOp, !sel.Distinct /* all */, inScope, outScope, branchScope)
+	}
+
+	outScope.expr = b.factory.ConstructWith(fromScope.expr, outScope.expr, &memo.WithPrivate{
+		ID:   withID,
+		Name: "grouping_sets",
+		OriginalExpr: &tree.Select{Select: &tree.SelectClause{
+			Exprs: tree.SelectExprs{tree.StarSelectExpr()},
+			From:  sel.From,
+			Where: sel.Where,
+		}},
+		// Inline the binding unless it is volatile.
+		Mtr: tree.CTEMaterializeNever,
+	})
+	return outScope, orderBy, true
+}
+
+// buildGroupingSetsInput builds a scan of the input of a query with grouping
+// sets, which is bound to withID. The returned scope has the same columns as
+// fromScope, with new column IDs.
+func (b *Builder) buildGroupingSetsInput(
+	withID opt.WithID, fromScope, inScope *scope,
+) (outScope *scope) {
+	outScope = inScope.push()
+	inCols := make(opt.ColList, len(fromScope.cols))
+	outCols := make(opt.ColList, len(fromScope.cols))
+	for i, col := range fromScope.cols {
+		md := b.factory.Metadata()
+		inCols[i] = col.id
+		outCols[i] = md.AddColumn(md.ColumnMeta(col.id).Alias, col.typ)
+		// Keep the name, table and visibility of the column, so that references
+		// to it resolve the same way in every branch.
+		col.scalar = nil
+		col.id = outCols[i]
+		outScope.cols = append(outScope.cols, col)
+	}
+
+	outScope.expr = b.factory.ConstructWithScan(&memo.WithScanPrivate{
+		With:    withID,
+		Name:    "grouping_sets",
+		InCols:  inCols,
+		OutCols: outCols,
+		ID:      b.factory.Metadata().NextUniqueID(),
+		Mtr:     tree.CTEMaterializeNever,
+	})
+	return outScope
+}
+
+// groupingSetsOf returns the grouping sets of a single item of the GROUP BY
+// clause of sel. fromScope is the input of the aggregation.
+func groupingSetsOf(fromScope *scope, sel *tree.SelectClause, e tree.Expr) []groupingSet {
+	gs, ok := e.(*tree.GroupingSet)
+	if !ok {
+		return []groupingSet{{resolveGroupingOrdinal(sel, e)}}
+	}
+
+	switch gs.Type {
+	case tree.RollupType:
+		units := groupingUnits(sel, gs.Exprs)
+		sets := make([]groupingSet, 0, len(units)+1)
+		for i := len(units); i >= 0; i-- {
+			var s groupingSet
+			for _, u := range units[:i] {
+				s = append(s, u...)
+			}
+			sets = append(sets, s)
+		}
+		return sets
+
+	case tree.CubeType:
+		units := groupingUnits(sel, gs.Exprs)
+		if len(units) >= 31 || 1<<len(units) > maxGroupingSets {
+			panic(errTooManyGroupingSets)
+		}
+		n := 1 << len(units)
+		sets := make([]groupingSet, 0, n)
+		for mask := n - 1; mask >= 0; mask-- {
+			var s groupingSet
+			for i, u := range units {
+				if mask&(1<<(len(units)-1-i)) != 0 {
+					s = append(s, u...)
+				}
+			}
+			sets = append(sets, s)
+		}
+		return sets
+
+	default:
+		var sets []groupingSet
+		for _, item := range gs.Exprs {
+			if t, ok := item.(*tree.Tuple); ok {
+				// A parenthesized list is a single grouping set.
+				s := make(groupingSet, len(t.Exprs))
+				for i := range t.Exprs {
+					s[i] = resolveGroupingOrdinal(sel, t.Exprs[i])
+				}
+				sets = append(sets, s)
+				continue
+			}
+			sets = append(sets, groupingSetsOf(fromScope, sel, tree.StripParens(item))...)
+			if len(sets) > maxGroupingSets {
+				panic(errTooManyGroupingSets)
+			}
+		}
+		return sets
+	}
+}
+
+// groupingUnits returns the elements of a ROLLUP or CUBE list. Each element is
+// either a single expression or a parenthesized list of expressions that are
+// added to or removed from grouping sets together.
+func groupingUnits(sel *tree.SelectClause, exprs tree.Exprs) []groupingSet {
+	units := make([]groupingSet, len(exprs))
+	for i, e := range exprs {
+		if t, ok := e.(*tree.Tuple); ok {
+			units[i] = make(groupingSet, len(t.Exprs))
+			for j := range t.Exprs {
+				units[i][j] = resolveGroupingOrdinal(sel, t.Exprs[j])
+			}
+			continue
+		}
+		units[i] = groupingSet{resolveGroupingOrdinal(sel, e)}
+	}
+	return units
+}
+
+// resolveGroupingOrdinal replaces a reference to the SELECT list by position,
+// e.g. the 1 in ROLLUP (1), with the referenced expression. Positions are not
+// resolved when the SELECT list contains a star, which is expanded later.
+func resolveGroupingOrdinal(sel *tree.SelectClause, e tree.Expr) tree.Expr {
+	e = tree.StripParens(e)
+	for i := range sel.Exprs {
+		if isStarTarget(sel.Exprs[i]) {
+			return e
+		}
+	}
+	if col := colIndex(len(sel.Exprs), e, "GROUP BY"); col != -1 {
+		return sel.Exprs[col].Expr
+	}
+	return e
+}
+
+// combineGroupingSets returns a new grouping set with the expressions of both
+// a and b, without duplicates.
+func combineGroupingSets(fromScope *scope, a, b groupingSet) groupingSet {
+	res := make(groupingSet, 0, len(a)+len(b))
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for _, s := range []groupingSet{a, b} {
+		for _, e := range s {
+			key := groupingExprKey(fromScope, e)
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				res = append(res, e)
+			}
+		}
+	}
+	return res
+}
+
+// groupingExprKey returns the string used to match an expression against the
+// grouping expressions of a query. Column references are resolved against
+// fromScope, so two expressions have the same key if they only differ in how
+// they refer to the same columns.
+func groupingExprKey(fromScope *scope, e tree.Expr) string {
+	r := groupingColumnResolver{fromScope: fromScope}
+	e, _ = tree.WalkExpr(&r, tree.StripParens(e))
+	return symbolicExprStr(e)
+}
+
+// groupingColumnResolver replaces the column references in an expression with
+// the columns of fromScope that they refer to. Unlike scope.resolveType, it
+// does not type check the expression or build any part of it. References that
+// cannot be resolved are left as they are, to be reported when the expression
+// is built.
+type groupingColumnResolver struct {
+	fromScope *scope
+}
+
+var _ tree.Visitor = &groupingColumnResolver{}
+
+// VisitPre is part of the Visitor interface.
+func (r *groupingColumnResolver) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	switch t := expr.(type) {
+	case *tree.UnresolvedName:
+		vn, err := t.NormalizeVarName()
+		if err != nil {
+			return false, expr
+		}
+		if c, ok := vn.(*tree.ColumnItem); ok {
+			return r.VisitPre(c)
+		}
+		return false, expr
+
+	case *tree.ColumnItem:
+		col, err := colinfo.ResolveColumnItem(r.fromScope.builder.ctx, r.fromScope, t)
+		if err != nil {
+			return false, expr
+		}
+		return false, col.(*scopeColumn)
+
+	case *tree.Subquery:
+		return false, expr
+	}
+	return true, expr
+}
+
+// VisitPost is part of the Visitor interface.
+func (*groupingColumnResolver) VisitPost(expr tree.Expr) tree.Expr { return expr }
+
+// isStarTarget returns true if the target is "*", "<table>.*" or "(Expr).*".
+func isStarTarget(target tree.SelectExpr) bool {
+	switch t := target.Expr.(type) {
+	case *tree.UnresolvedName:
+		return t.Star
+	case tree.UnqualifiedStar, *tree.AllColumnsSelector, *tree.TupleStar:
+		return true
+	}
+	return false
+}
+
+// containsGroupingExpr returns true if the SELECT list, HAVING clause or ORDER
+// BY clause contains a GROUPING(...) expression.
+func containsGroupingExpr(sel *tree.SelectClause, orderBy tree.OrderBy) bool {
+	var v groupingExprFinder
+	for i := range sel.Exprs {
+		tree.WalkExprConst(&v, sel.Exprs[i].Expr)
+	}
+	if sel.Having != nil {
+		tree.WalkExprConst(&v, sel.Having.Expr)
+	}
+	for i := range orderBy {
+		if orderBy[i].Expr != nil {
+			tree.WalkExprConst(&v, orderBy[i].Expr)
+		}
+	}
+	return v.found
+}
+
+type groupingExprFinder struct {
+	found bool
+}
+
+var _ tree.Visitor = &groupingExprFinder{}
+
+// VisitPre is part of the Visitor interface.
+func (v *groupingExprFinder) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	if _, ok := expr.(*tree.GroupingExpr); ok {
+		v.found = true
+	}
+	return !v.found, expr
+}
+
+// VisitPost is part of the Visitor interface.
+func (*groupingExprFinder) VisitPost(expr tree.Expr) tree.Expr { return expr }
+
+// groupingSetReplacer rewrites the expressions of a SELECT clause for a single
+// grouping set.
+type groupingSetReplacer struct {
+	b *Builder
+
+	// fromScope is the input of the aggregation. It is used to resolve column
+	// references when matching grouping expressions.
+	fromScope *scope
+
+	// all contains the keys of the grouping expressions of all grouping sets.
+	all map[string]struct{}
+
+	// grouped contains the keys of the grouping expressions of this grouping
+	// set.
+	grouped map[string]struct{}
+
+	// rejectWindows is true if window functions cannot be built, because the
+	// query expands to multiple grouping sets.
+	rejectWindows bool
+}
+
+var _ tree.Visitor = &groupingSetReplacer{}
+
+// replaceSelectClause returns a copy of sel that groups by the given grouping
+// set. If aliasTargets is true, every SELECT target is given an explicit alias
+// so that the output columns of all grouping sets have the same names.
+func (r *groupingSetReplacer) replaceSelectClause(
+	sel *tree.SelectClause, set groupingSet, aliasTargets bool,
+) *tree.SelectClause {
+	branch := *sel
+	branch.GroupBy = tree.GroupBy(set)
+	branch.Exprs = make(tree.SelectExprs, len(sel.Exprs))
+	for i, target := range sel.Exprs {
+		if aliasTargets {
+			if isStarTarget(target) {
+				panic(unimplemented.NewWithIssue(46280, "* in the SELECT list of a query with grouping sets"))
+			}
+			target.As = tree.UnrestrictedName(r.b.getColName(target))
+		}
+		target.Expr = r.replace(target.Expr)
+		branch.Exprs[i] = target
+	}
+	if sel.Having != nil {
+		branch.Having = &tree.Where{Type: tree.AstHaving, Expr: r.replace(sel.Having.Expr)}
+	} else if len(set) == 0 {
+		// The empty grouping set produces a single row, even when there are no
+		// aggregate functions.
+		branch.Having = &tree.Where{Type: tree.AstHaving, Expr: tree.DBoolTrue}
+	}
+	return &branch
+}
+
+func (r *groupingSetReplacer) replace(expr tree.Expr) tree.Expr {
+	expr, _ = tree.WalkExpr(r, expr)
+	return expr
+}
+
+// VisitPre is part of the Visitor interface.
+func (r *groupingSetReplacer) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	switch t := expr.(type) {
+	case *tree.GroupingExpr:
+		var mask int64
+		for _, arg := range t.Exprs {
+			key := groupingExprKey(r.fromScope, arg)
+			if _, ok := r.all[key]; !ok {
+				panic(errInvalidGroupingArg)
+			}
+			mask <<= 1
+			if _, ok := r.grouped[key]; !ok {
+				mask |= 1
+			}
+		}
+		return false, tree.NewDInt(tree.DInt(mask))
+
+	case *tree.FuncExpr:
+		if t.WindowDef != nil && r.rejectWindows {
+			panic(unimplemented.NewWithIssue(46280, "window functions with grouping sets"))
+		}
+		// Arguments of aggregate functions are not affected by grouping.
+		def, err := t.Func.Resolve(r.b.ctx, r.b.semaCtx.SearchPath, r.b.semaCtx.FunctionResolver)
+		if err == nil && t.WindowDef == nil && isAggregate(def) {
+			return false, expr
+		}
+
+	case *tree.Subquery:
+		return false, expr
+	}
+
+	key := groupingExprKey(r.fromScope, expr)
+	if _, ok := r.all[key]; ok {
+		if _, ok := r.grouped[key]; !ok {
+			return false, tree.DNull
+		}
+	}
+	return true, expr
+}
+
+// VisitPost is part of the Visitor interface.
+func (*groupingSetReplacer) VisitPost(expr tree.Expr) tree.Expr { return expr }
+
+var (
+	errTooManyGroupingSets = pgerror.Newf(pgcode.ProgramLimitExceeded,
+		"too many grouping sets present (maximum %d)", maxGroupingSets)
+	errInvalidGroupingArg = pgerror.New(pgcode.Grouping,
+		"arguments to GROUPING must be grouping expressions of the associated query level")
+)
@@ -210,6 +210,12 @@ type mutationBuilder struct {
 	// RETURNING clause, respectively.
 	extraAccessibleCols []scopeColumn
 
+	// oldReturningCols stores the columns that the RETURNING clause of an
+	// UPDATE can refer to using the "old" table name. They are the fetch
+	// columns, which hold the values of each row before the update and are
+	// passed through the Update operator.
+	oldReturningCols []scopeColumn
+
 	// fkCheckHelper is used to prevent allocating the helper separately.
 	fkCheckHelper fkCheckHelper
 
@@ -1197,6 +1203,11 @@ func (mb *mutationBuilder) buildReturning(returning *tree.ReturningExprs) {
 	// clause, respectively.
 	inScope.appendColumns(mb.extraAccessibleCols)
 
+	// The RETURNING clause of an UPDATE or DELETE can also refer to the values
+	// of each row before and after the mutation, using the "old" and "new"
+	// table names.
+	inScope = mb.buildOldAndNewReturningCols(returning, inScope)
+
 	// Construct the Project operator that projects the RETURNING expressions.
 	outScope := inScope.replace()
 	mb.b.analyzeReturningList(returning, nil /* desiredTypes */, inScope, outScope)
@@ -1205,6 +1216,136 @@ func (mb *mutationBuilder) buildReturning(returning *tree.ReturningExprs) {
 	mb.outScope = outScope
 }
 
+// returningOldName and returningNewName are the names by which the RETURNING
+// clause of an UPDATE or DELETE can refer to the values of each row before and
+// after the mutation. For example:
+//
+//	UPDATE t SET v = v + 1 RETURNING old.v, new.v
+//
+// The names are not exposed if they conflict with the name of the target
+// table or of a table in the FROM or USING clause.
+var (
+	returningOldName = tree.MakeUnqualifiedTableName("old")
+	returningNewName = tree.MakeUnqualifiedTableName("new")
+)
+
+// returningUsesTable returns true if the given RETURNING clause refers to
+// the given table name, and the name does not conflict with another table in
+// scope. The columns of the "old" and "new" tables are only built when they
+// are referenced, so that other mutations are planned as before.
+func (mb *mutationBuilder) returningUsesTable(
+	returning *tree.ReturningExprs, name tree.TableName,
+) bool {
+	if mb.alias.ObjectName == name.ObjectName {
+		return false
+	}
+	for i := range mb.extraAccessibleCols {
+		if mb.extraAccessibleCols[i].table.ObjectName == name.ObjectName {
+			return false
+		}
+	}
+	v := tableReferenceVisitor{name: string(name.ObjectName)}
+	for i := range *returning {
+		tree.WalkExprConst(&v, (*returning)[i].Expr)
+	}
+	return v.found
+}
+
+// tableReferenceVisitor searches an expression for column references, or
+// star expansions, qualified with the given table name.
+type tableReferenceVisitor struct {
+	name  string
+	found bool
+}
+
+var _ tree.Visitor = &tableReferenceVisitor{}
+
+func (v *tableReferenceVisitor) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	if v.found {
+		return false, expr
+	}
+	if n, ok := expr.(*tree.UnresolvedName); ok {
+		if n.NumParts > 1 && n.Parts[1] == v.name {
+			v.found = true
+		}
+		return false, expr
+	}
+	return true, expr
+}
+
+func (v *tableReferenceVisitor) VisitPost(expr tree.Expr) tree.Expr { return expr }
+
+// makeReturningCols returns a scope column for each visible column of the
+// target table, qualified with the given table name. colIDs is indexed by the
+// table ordinal and provides the ID of each column. The columns are included in
+// the expansion of "<name>.*" but not of "*", and unqualified references
+// resolve to the target table's columns first.
+func (mb *mutationBuilder) makeReturningCols(
+	name tree.TableName, colIDs opt.OptionalColList,
+) []scopeColumn {
+	cols := make([]scopeColumn, 0, mb.tab.ColumnCount())
+	for i, n := 0, mb.tab.ColumnCount(); i < n; i++ {
+		tabCol := mb.tab.Column(i)
+		if tabCol.Kind() != cat.Ordinary || tabCol.Visibility() != cat.Visible || colIDs[i] == 0 {
+			continue
+		}
+		cols = append(cols, scopeColumn{
+			name:       scopeColName(tabCol.ColName()),
+			table:      name,
+			typ:        tabCol.DatumType(),
+			id:         colIDs[i],
+			visibility: accessibleByQualifiedStar,
+		})
+	}
+	return cols
+}
+
+// tableColIDs returns the IDs of the target table's columns, indexed by table
+// ordinal. These are the columns returned by the mutation operator.
+func (mb *mutationBuilder) tableColIDs() opt.OptionalColList {
+	colIDs := make(opt.OptionalColList, mb.tab.ColumnCount())
+	for i := range colIDs {
+		colIDs[i] = mb.tabID.ColumnID(i)
+	}
+	return colIDs
+}
+
+// buildOldAndNewReturningCols adds the columns of the "old" and "new" tables
+// to the given RETURNING scope of an UPDATE or DELETE, and returns the
+// resulting scope. For an UPDATE, "old" refers to the fetched values and "new"
+// to the updated values. For a DELETE, "old" refers to the deleted values and
+// the columns of "new" are NULL, which requires an additional projection.
+func (mb *mutationBuilder) buildOldAndNewReturningCols(
+	returning *tree.ReturningExprs, inScope *scope,
+) *scope {
+	switch mb.outScope.expr.Op() {
+	case opt.UpdateOp:
+		inScope.appendColumns(mb.oldReturningCols)
+		if mb.returningUsesTable(returning, returningNewName) {
+			inScope.appendColumns(mb.makeReturningCols(returningNewName, mb.tableColIDs()))
+		}
+
+	case opt.DeleteOp:
+		if mb.returningUsesTable(returning, returningOldName) {
+			inScope.appendColumns(mb.makeReturningCols(returningOldName, mb.tableColIDs()))
+		}
+		if mb.returningUsesTable(returning, returningNewName) {
+			projectionsScope := inScope.replace()
+			projectionsScope.appendColumnsFromScope(inScope)
+			for _, col := range mb.makeReturningCols(returningNewName, mb.tableColIDs()) {
+				nullCol := mb.b.synthesizeColumn(
+					projectionsScope, col.name, col.typ, nil /* expr */, mb.b.factory.ConstructNull(col.typ),
+				)
+				nullCol.table = col.table
+				nullCol.visibility = col.visibility
+			}
+			mb.b.constructProjectForScope(inScope, projectionsScope)
+			return projectionsScope
+		}
+	}
+	return inScope
+}
+
 // checkNumCols raises an error if the expected number of columns does not match
 // the actual number of columns.
 func (mb *mutationBuilder) checkNumCols(expected, actual int) {
@@ -175,7 +175,19 @@ func (b *Builder) buildScalar(
 
 		for _, subscript := range t.Indirection {
 			if subscript.Slice {
-				panic(unimplementedWithIssueDetailf(32551, "", "array slicing is not supported"))
+				begin, end := memo.EmptyScalarListExpr, memo.EmptyScalarListExpr
+				if subscript.Begin != nil {
+					begin = memo.ScalarListExpr{
+						b.buildScalar(subscript.Begin.(tree.TypedExpr), inScope, nil, nil, colRefs),
+					}
+				}
+				if subscript.End != nil {
+					end = memo.ScalarListExpr{
+						b.buildScalar(subscript.End.(tree.TypedExpr), inScope, nil, nil, colRefs),
+					}
+				}
+				out = b.factory.ConstructArraySlice(out, begin, end)
+				continue
 			}
 
 			out = b.factory.ConstructIndirection(
@@ -808,6 +820,14 @@ func (b *Builder) constructComparison(
 		return b.factory.ConstructOverlaps(left, right)
 	case treecmp.TSMatches:
 		return b.factory.ConstructTSMatches(left, right)
+	case treecmp.WordSimilar:
+		return b.factory.ConstructWordSimilar(left, right)
+	case treecmp.WordSimilarCommuted:
+		return b.factory.ConstructWordSimilarCommuted(left, right)
+	case treecmp.StrictWordSimilar:
+		return b.factory.ConstructStrictWordSimilar(left, right)
+	case treecmp.StrictWordSimilarCommuted:
+		return b.factory.ConstructStrictWordSimilarCommuted(left, right)
 	}
 	panic(errors.AssertionFailedf("unhandled comparison operator: %s", redact.Safe(cmp.Operator)))
 }
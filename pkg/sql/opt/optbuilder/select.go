@@ -1026,6 +1026,11 @@ func (b *Builder) buildSelectStmt(
 		return b.buildSelect(stmt.Select, lockCtx, desiredTypes, inScope)
 
 	case *tree.SelectClause:
+		if gsScope, _, ok := b.buildGroupingSets(
+			stmt, nil /* orderBy */, lockCtx, desiredTypes, inScope,
+		); ok {
+			return gsScope
+		}
 		return b.buildSelectClause(stmt, nil /* orderBy */, lockCtx, desiredTypes, inScope)
 
 	case *tree.UnionClause:
@@ -1142,7 +1147,15 @@ func (b *Builder) buildSelectStmtWithoutParens(
 			"%T in buildSelectStmtWithoutParens", wrapped))
 
 	case *tree.SelectClause:
-		outScope = b.buildSelectClause(t, orderBy, lockCtx, desiredTypes, inScope)
+		// Queries with grouping sets are built as a union with one branch per
+		// grouping set. See buildGroupingSets.
+		if gsScope, gsOrderBy, ok := b.buildGroupingSets(
+			t, orderBy, lockCtx, desiredTypes, inScope,
+		); ok {
+			outScope, orderBy = gsScope, gsOrderBy
+		} else {
+			outScope = b.buildSelectClause(t, orderBy, lockCtx, desiredTypes, inScope)
+		}
 
 	case *tree.UnionClause:
 		b.rejectIfLocking(lockCtx.locking, "UNION/INTERSECT/EXCEPT")
@@ -1215,6 +1228,22 @@ func (b *Builder) buildSelectClause(
 	b.processWindowDefs(sel, fromScope)
 	b.buildWhere(sel.Where, fromScope)
 
+	return b.buildSelectClauseWithFromScope(sel, orderBy, lockCtx, desiredTypes, fromScope)
+}
+
+// buildSelectClauseWithFromScope builds the given select clause on top of
+// fromScope, which contains the already built FROM and WHERE clauses of sel.
+// The FROM and WHERE clauses of sel are ignored.
+//
+// See Builder.buildStmt for a description of the remaining input and
+// return values.
+func (b *Builder) buildSelectClauseWithFromScope(
+	sel *tree.SelectClause,
+	orderBy tree.OrderBy,
+	lockCtx lockingContext,
+	desiredTypes []*types.T,
+	fromScope *scope,
+) (outScope *scope) {
 	projectionsScope := fromScope.replace()
 
 	// This is where the magic happens. When this call reaches an aggregate
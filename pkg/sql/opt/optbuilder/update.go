@@ -358,6 +358,15 @@ func (mb *mutationBuilder) buildUpdate(returning *tree.ReturningExprs) {
 			private.PassthroughCols = append(private.PassthroughCols, col.id)
 		}
 	}
+	// Pass through the fetch columns so that the RETURNING clause can refer to
+	// the values of each row before the update. Unreferenced columns are pruned
+	// during normalization.
+	if returning != nil && mb.returningUsesTable(returning, returningOldName) {
+		mb.oldReturningCols = mb.makeReturningCols(returningOldName, mb.fetchColIDs)
+		for i := range mb.oldReturningCols {
+			private.PassthroughCols = append(private.PassthroughCols, mb.oldReturningCols[i].id)
+		}
+	}
 	mb.outScope.expr = mb.b.factory.ConstructUpdate(
 		mb.outScope.expr, mb.uniqueChecks, mb.fkChecks, private,
 	)
@@ -105,7 +105,7 @@ func interestingOrderingsForScan(scan *memo.ScanExpr) props.OrderingSet {
 
 	addIndexOrdering := func(indexOrd cat.IndexOrdinal, fds *props.FuncDepSet, exactPrefix int) {
 		index := tab.Index(indexOrd)
-		if index.IsInverted() {
+		if index.IsInverted() || index.IsVector() {
 			return
 		}
 		numIndexCols := index.KeyColumnCount()
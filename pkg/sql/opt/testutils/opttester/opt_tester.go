@@ -41,8 +41,10 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/constraint"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec/execbuilder"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/idxconstraint"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/indexrec"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/norm"
@@ -209,9 +211,13 @@ type Flags struct {
 	Database string
 
 	// Table specifies the current table to use for the command. This field is
-	// only used by the inject-stats commands.
+	// only used by the inject-stats and constrain commands.
 	Table string
 
+	// Index specifies the index of Table to use for the command. This field is
+	// only used by the constrain command.
+	Index string
+
 	// IgnoreTables specifies the subset of stats tables which should not be
 	// outputted by the stats-quality command.
 	IgnoreTables intsets.Fast
@@ -453,6 +459,13 @@ func New(catalog cat.Catalog, sqlStr string) *OptTester {
 //     Walks through the SQL statement and recommends indexes to add in order to
 //     speed up its execution, if these indexes exist. See the indexrec package.
 //
+//   - constrain table=... index=...
+//
+//     Normalizes the SQL statement, which must filter a scan of the given
+//     table, and outputs the constraint that the idxconstraint package derives
+//     from the filters for the given index, whether the constraint is tight,
+//     and the filters that remain to be applied after the constrained scan.
+//
 // Supported flags:
 //
 //   - format: controls the formatting of expressions for build, opt, and
@@ -503,7 +516,9 @@ func New(catalog cat.Catalog, sqlStr string) *OptTester {
 //     used by the stats-quality command when rewriteActualFlag=true.
 //
 //   - table: used to set the current table used by the command. This is used by
-//     the inject-stats command.
+//     the inject-stats and constrain commands.
+//
+//   - index: used to set the index used by the constrain command.
 //
 //   - ignore-tables: specifies the set of stats tables for which stats quality
 //     comparisons should not be outputted. Only used with the stats-quality
@@ -873,6 +888,13 @@ func (ot *OptTester) runCommandInternal(tb testing.TB, d *datadriven.TestData) s
 		}
 		return result
 
+	case "constrain":
+		result, err := ot.Constrain()
+		if err != nil {
+			d.Fatalf(tb, "%+v", err)
+		}
+		return result
+
 	case "index-recommendations":
 		result, err := ot.IndexRecommendations()
 		if err != nil {
@@ -1112,6 +1134,12 @@ func (f *Flags) Set(arg datadriven.CmdArg) error {
 		}
 		f.Table = arg.Vals[0]
 
+	case "index":
+		if len(arg.Vals) != 1 {
+			return fmt.Errorf("index requires one argument")
+		}
+		f.Index = arg.Vals[0]
+
 	case "ignore-tables":
 		var tables intsets.Fast
 		addTables := func(val string) error {
@@ -2304,6 +2332,99 @@ func (ot *OptTester) IndexCandidates() (string, error) {
 	return strings.Join(tablesOutput, ""), nil
 }
 
+// Constrain is used with the constrain option. It normalizes the SQL
+// statement, finds the Select that filters a Scan of the table given by the
+// table flag, and derives a constraint from its filters for the index given by
+// the index flag, in the same way as the GenerateConstrainedScans rule. It
+// returns the constraint, its tightness and the remaining filters.
+func (ot *OptTester) Constrain() (string, error) {
+	if ot.Flags.Table == "" {
+		return "", fmt.Errorf("table not specified")
+	}
+	if ot.Flags.Index == "" {
+		return "", fmt.Errorf("index not specified")
+	}
+	o := ot.makeOptimizer()
+	o.NotifyOnMatchedRule(func(ruleName opt.RuleName) bool {
+		return ruleName.IsNormalize() && !ot.Flags.DisableRules.Contains(int(ruleName))
+	})
+	if !ot.Flags.NoStableFolds {
+		o.Factory().FoldingControl().AllowStableFolds()
+	}
+	root, err := ot.optimizeExpr(o, nil)
+	if err != nil {
+		return "", err
+	}
+	md := o.Memo().Metadata()
+
+	// Find the filters applied to a scan of the table.
+	var sel *memo.SelectExpr
+	var scan *memo.ScanExpr
+	var find func(e opt.Expr)
+	find = func(e opt.Expr) {
+		if s, ok := e.(*memo.SelectExpr); ok {
+			if sc, ok := s.Input.(*memo.ScanExpr); ok &&
+				string(md.Table(sc.Table).Name()) == ot.Flags.Table {
+				sel, scan = s, sc
+				return
+			}
+		}
+		for i, n := 0, e.ChildCount(); i < n && sel == nil; i++ {
+			find(e.Child(i))
+		}
+	}
+	find(root)
+	if sel == nil {
+		return "", fmt.Errorf("no filtered scan of table %s", ot.Flags.Table)
+	}
+
+	tabMeta := md.TableMeta(scan.Table)
+	var index cat.Index
+	for i, n := 0, tabMeta.Table.IndexCount(); i < n; i++ {
+		if string(tabMeta.Table.Index(i).Name()) == ot.Flags.Index {
+			index = tabMeta.Table.Index(i)
+			break
+		}
+	}
+	if index == nil {
+		return "", fmt.Errorf("index %s not found on table %s", ot.Flags.Index, ot.Flags.Table)
+	}
+	columns := make([]opt.OrderingColumn, index.LaxKeyColumnCount())
+	var notNullCols opt.ColSet
+	for i := range columns {
+		col := index.Column(i)
+		colID := scan.Table.ColumnID(col.Ordinal())
+		columns[i] = opt.MakeOrderingColumn(colID, col.Descending)
+		if !col.IsNullable() {
+			notNullCols.Add(colID)
+		}
+	}
+
+	var ic idxconstraint.Instance
+	ic.Init(
+		ot.ctx, sel.Filters, nil /* optionalFilters */, columns, notNullCols,
+		tabMeta.ComputedCols, tabMeta.ColsInComputedColsExpressions,
+		true /* consolidate */, &ot.evalCtx, o.Factory(),
+		tabMeta.IndexPartitionLocality(index.Ordinal()), func() {}, /* checkCancellation */
+	)
+	var c constraint.Constraint
+	ic.Constraint(&c)
+	remainingFilters := ic.RemainingFilters()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "constraint: %s\n", c.String())
+	fmt.Fprintf(&buf, "tight: %t\n", ic.Tight())
+	if remainingFilters.IsTrue() {
+		buf.WriteString("remaining filters: none\n")
+	} else {
+		buf.WriteString("remaining filters:\n")
+		buf.WriteString(memo.FormatExpr(
+			ot.ctx, &remainingFilters, ot.Flags.ExprFormat, false /* redactableValues */, o.Memo(), ot.catalog,
+		))
+	}
+	return buf.String(), nil
+}
+
 // IndexRecommendations is used with the index-recommendations option. It
 // determines index recommendations for the SQL statement, if they exist, and
 // formats them as a human-readable string.
@@ -135,6 +135,9 @@ var systemTables = []string{
 	systemschema.StatementExecutionStatsTableSchema,
 	systemschema.TableMetadataTableSchema,
 	systemschema.PreparedTransactionsTableSchema,
+	systemschema.NotificationsTableSchema,
+	systemschema.LargeObjectsTableSchema,
+	systemschema.LargeObjectMetadataTableSchema,
 }
 
 func init() {
@@ -549,6 +549,9 @@ func (c *coster) ComputeCost(candidate memo.RelExpr, required *physical.Required
 	case opt.InvertedFilterOp:
 		cost = c.computeInvertedFilterCost(candidate.(*memo.InvertedFilterExpr))
 
+	case opt.VectorSearchOp:
+		cost = c.computeVectorSearchCost(candidate.(*memo.VectorSearchExpr))
+
 	case opt.ValuesOp:
 		cost = c.computeValuesCost(candidate.(*memo.ValuesExpr))
 
@@ -956,6 +959,18 @@ func (c *coster) computeInvertedFilterCost(invFilter *memo.InvertedFilterExpr) m
 	return cost
 }
 
+func (c *coster) computeVectorSearchCost(search *memo.VectorSearchExpr) memo.Cost {
+	// The search reads a bounded number of index partitions and compares the
+	// query vector with each vector in them. As a rough estimate, assume that
+	// each returned candidate requires reading one partition.
+	rowCount := search.Relational().Statistics().RowCount
+	cost := memo.Cost{C: rowCount * randIOCostFactor}
+
+	// Add the CPU cost of emitting the rows.
+	cost.C += rowCount * cpuCostFactor
+	return cost
+}
+
 func (c *coster) computeValuesCost(values *memo.ValuesExpr) memo.Cost {
 	return memo.Cost{C: values.Relational().Statistics().RowCount * cpuCostFactor}
 }
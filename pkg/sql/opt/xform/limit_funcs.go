@@ -347,6 +347,126 @@ func (c *CustomFuncs) GenerateLimitedTopKScans(
 	})
 }
 
+// GenerateVectorSearch enumerates the vector indexes on the given Scan
+// operator's table and generates a VectorSearch for each index on the vector
+// column that the TopK orders by. An IndexJoin fetches the remaining columns of
+// the candidate rows, the given filters are applied to them, and the given
+// projections compute their exact distances. The input is either the Scan or
+// a Select of the filters on it. See the GenerateVectorSearch rule for
+// details.
+func (c *CustomFuncs) GenerateVectorSearch(
+	grp memo.RelExpr,
+	required *physical.Required,
+	input memo.RelExpr,
+	sp *memo.ScanPrivate,
+	filters memo.FiltersExpr,
+	projections memo.ProjectionsExpr,
+	passthrough opt.ColSet,
+	tp *memo.TopKPrivate,
+) {
+	// VectorSearch cannot be executed yet.
+	// TODO(#137370): remove the setting once it can.
+	if !c.e.evalCtx.SessionData().OptimizerUseVectorSearch {
+		return
+	}
+	// The candidates must be fetched with an index join, and VectorSearch does
+	// not support row-level locking.
+	if sp.Flags.NoIndexJoin || !sp.Locking.IsNoOp() {
+		return
+	}
+	// The first ordering column must be the ascending distance between a
+	// vector column and the query vector.
+	if len(tp.Ordering.Columns) == 0 || tp.Ordering.Columns[0].Descending {
+		return
+	}
+	vectorCol, queryVector, ok := c.vectorDistanceOperands(projections, tp.Ordering.Columns[0].Group)
+	if !ok {
+		return
+	}
+	// Vector indexes do not contain rows with a NULL vector, so the search is
+	// only equivalent to the TopK if the vector column is NOT NULL or the
+	// filters reject NULL vectors.
+	if !input.Relational().NotNullCols.Contains(vectorCol) {
+		return
+	}
+
+	var pkCols opt.ColSet
+	var iter scanIndexIter
+	iter.Init(c.e.evalCtx, c.e, c.e.mem, &c.im, sp, nil /* filters */, rejectPrimaryIndex|rejectNonVectorIndexes)
+	iter.ForEach(func(index cat.Index, filters memo.FiltersExpr, indexCols opt.ColSet, isCovering bool, constProj memo.ProjectionsExpr) {
+		// The iterator only produces pseudo-partial indexes (the predicate is
+		// true) because no filters are passed to iter.Init. See
+		// GenerateLimitedTopKScans.
+		if len(constProj) != 0 {
+			panic(errors.AssertionFailedf("expected constProj to be empty"))
+		}
+
+		// Searching an index with prefix columns requires constraining them,
+		// which is not supported.
+		if index.PrefixColumnCount() > 0 {
+			return
+		}
+		if sp.Table.ColumnID(index.VectorColumn().Ordinal()) != vectorCol {
+			return
+		}
+
+		// Calculate the PK columns once.
+		if pkCols.Empty() {
+			pkCols = c.PrimaryKeyCols(sp.Table)
+		}
+
+		search := c.e.f.ConstructVectorSearch(queryVector, &memo.VectorSearchPrivate{
+			Table:               sp.Table,
+			Index:               index.Ordinal(),
+			Cols:                pkCols,
+			TargetNeighborCount: tp.K,
+		})
+		var candidates memo.RelExpr = c.e.f.ConstructIndexJoin(search, &memo.IndexJoinPrivate{
+			Table: sp.Table,
+			Cols:  sp.Cols,
+		})
+		if len(filters) > 0 {
+			candidates = c.e.f.ConstructSelect(candidates, filters)
+		}
+		project := c.e.f.ConstructProject(candidates, projections, passthrough)
+		grp.Memo().AddTopKToGroup(&memo.TopKExpr{Input: project, TopKPrivate: *tp}, grp)
+	})
+}
+
+// vectorDistanceOperands returns the vector column and the query vector of the
+// VectorDistance projection that produces one of the given ordering columns.
+// It returns ok=false if there is no such projection, or if the query vector
+// is not a constant or placeholder.
+func (c *CustomFuncs) vectorDistanceOperands(
+	projections memo.ProjectionsExpr, orderingCols opt.ColSet,
+) (vectorCol opt.ColumnID, queryVector opt.ScalarExpr, ok bool) {
+	isQueryVector := func(e opt.ScalarExpr) bool {
+		switch e.Op() {
+		case opt.ConstOp, opt.PlaceholderOp:
+			return true
+		}
+		return false
+	}
+	for i := range projections {
+		if !orderingCols.Contains(projections[i].Col) {
+			continue
+		}
+		distance, ok := projections[i].Element.(*memo.VectorDistanceExpr)
+		if !ok {
+			continue
+		}
+		left, right := distance.Left, distance.Right
+		if isQueryVector(left) {
+			// The distance is symmetric.
+			left, right = right, left
+		}
+		if v, ok := left.(*memo.VariableExpr); ok && isQueryVector(right) {
+			return v.Col, right, true
+		}
+	}
+	return 0, nil, false
+}
+
 // getPrefixFromOrdering returns an OrderingChoice that holds the prefix
 // of Ordering o that satisfies part of the required OrderingChoice intraOrd,
 // a bool indicating whether the entire Ordering o was satisfied, and a bool
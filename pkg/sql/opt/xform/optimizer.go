@@ -91,6 +91,10 @@ type Optimizer struct {
 	// It can be set via a call to the NotifyOnAppliedRule method.
 	appliedRule AppliedRuleFunc
 
+	// planningStats, if non-nil, collects statistics about the work done while
+	// planning the current query. It is set via a call to CollectPlanningStats.
+	planningStats *PlanningStats
+
 	// JoinOrderBuilder adds new join orderings to the memo.
 	jb JoinOrderBuilder
 
@@ -236,6 +240,7 @@ func (o *Optimizer) Memo() *memo.Memo {
 func (o *Optimizer) Optimize() (_ opt.Expr, err error) {
 	log.VEventf(o.ctx, 1, "optimize start")
 	defer log.VEventf(o.ctx, 1, "optimize finish")
+	defer o.startOptimizeTimer()()
 	defer func() {
 		if r := recover(); r != nil {
 			// This code allows us to propagate internal errors without having to add
@@ -0,0 +1,116 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xform
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/opt"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/cockroach/pkg/util/treeprinter"
+)
+
+// PlanningStats contains counters and timings that describe the work done by
+// the optimizer while planning a single query. It is displayed by EXPLAIN
+// (OPT, STATS) so that planning-time regressions can be localized to a phase
+// or to the rules that fired.
+type PlanningStats struct {
+	// BuildTime is the time spent building the memo with optbuilder. Since
+	// normalization rules fire while the expression tree is constructed, it
+	// includes the time spent normalizing.
+	BuildTime time.Duration
+
+	// OptimizeTime is the time spent in Optimizer.Optimize, which interleaves
+	// exploration and costing.
+	OptimizeTime time.Duration
+
+	// NormRulesApplied is the number of times a normalization rule was applied.
+	NormRulesApplied int
+
+	// ExploreRulesApplied is the number of times an exploration rule was
+	// applied.
+	ExploreRulesApplied int
+}
+
+// CollectPlanningStats enables collection of planning statistics for the
+// current query and returns the PlanningStats that will be populated. It must
+// be called after Init and before the memo is built, so that normalization
+// rules applied during construction are counted. The caller is responsible
+// for recording BuildTime, since the optimizer does not build the memo itself.
+func (o *Optimizer) CollectPlanningStats() *PlanningStats {
+	o.planningStats = &PlanningStats{}
+	stats := o.planningStats
+	prev := o.appliedRule
+	o.NotifyOnAppliedRule(func(ruleName opt.RuleName, source, target opt.Expr) {
+		if ruleName.IsNormalize() {
+			stats.NormRulesApplied++
+		} else {
+			stats.ExploreRulesApplied++
+		}
+		if prev != nil {
+			prev(ruleName, source, target)
+		}
+	})
+	return stats
+}
+
+// PlanningStats returns the statistics collected for the current query, or nil
+// if CollectPlanningStats was not called.
+func (o *Optimizer) PlanningStats() *PlanningStats {
+	return o.planningStats
+}
+
+// startOptimizeTimer returns a function that records the time elapsed since it
+// was called as the OptimizeTime, if planning statistics are being collected.
+func (o *Optimizer) startOptimizeTimer() func() {
+	if o.planningStats == nil {
+		return func() {}
+	}
+	start := timeutil.Now()
+	return func() {
+		o.planningStats.OptimizeTime = timeutil.Since(start)
+	}
+}
+
+// FormatPlanningStats returns a string representation of the planning
+// statistics collected for the current query, along with counts of the groups
+// and expressions reachable from the root of the memo. It returns the empty
+// string if statistics were not collected.
+func (o *Optimizer) FormatPlanningStats() string {
+	stats := o.planningStats
+	if stats == nil {
+		return ""
+	}
+
+	// Reuse the memo formatter to number the groups reachable from the root.
+	mf := makeMemoFormatter(o, FmtPretty, false /* redactableValues */)
+	mf.groupIdx = make(map[opt.Expr]int)
+	mf.numberMemo(o.mem.RootExpr())
+	var relGroups, relExprs, scalarGroups int
+	for i := range mf.groups {
+		rel, ok := mf.groups[i].first.(memo.RelExpr)
+		if !ok {
+			scalarGroups++
+			continue
+		}
+		relGroups++
+		for e := rel; e != nil; e = e.NextExpr() {
+			relExprs++
+		}
+	}
+
+	tp := treeprinter.New()
+	n := tp.Child("planning stats")
+	n.Childf("build and normalize time: %s", stats.BuildTime)
+	n.Childf("explore and cost time: %s", stats.OptimizeTime)
+	n.Childf("normalization rules applied: %d", stats.NormRulesApplied)
+	n.Childf("exploration rules applied: %d", stats.ExploreRulesApplied)
+	n.Childf("relational groups: %d", relGroups)
+	n.Childf("relational expressions: %d", relExprs)
+	n.Childf("scalar groups: %d", scalarGroups)
+	return tp.String()
+}
@@ -39,6 +39,11 @@ const (
 	// rejectNonPartialIndexes excludes any non-partial indexes during
 	// iteration.
 	rejectNonPartialIndexes
+
+	// rejectNonVectorIndexes excludes any non-vector indexes during iteration.
+	// Vector indexes can only be used by VectorSearch operators, so they are
+	// excluded unless this flag is set.
+	rejectNonVectorIndexes
 )
 
 // scanIndexIter is a helper struct that facilitates iteration over the indexes
@@ -237,6 +242,12 @@ func (it *scanIndexIter) ForEachStartingAfter(ord int, f enumerateIndexFunc) {
 			continue
 		}
 
+		// Skip over vector indexes unless rejectNonVectorIndexes is set, and
+		// over non-vector indexes if it is.
+		if it.hasRejectFlags(rejectNonVectorIndexes) != index.IsVector() {
+			continue
+		}
+
 		pred, isPartialIndex := it.tabMeta.PartialIndexPredicate(ord)
 
 		// Skip over partial indexes if rejectPartialIndexes is set.
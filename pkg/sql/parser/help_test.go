@@ -32,6 +32,9 @@ func TestContextualHelp(t *testing.T) {
 	}{
 		{`ALTER ??`, `ALTER`},
 
+		{`ALTER EXTERNAL CONNECTION ??`, `ALTER EXTERNAL CONNECTION`},
+		{`ALTER EXTERNAL CONNECTION blah ??`, `ALTER EXTERNAL CONNECTION`},
+
 		{`ALTER CHANGEFEED ??`, `ALTER CHANGEFEED`},
 		{`ALTER CHANGEFEED 123 ADD ??`, `ALTER CHANGEFEED`},
 		{`ALTER CHANGEFEED 123 DROP ??`, `ALTER CHANGEFEED`},
@@ -443,6 +446,9 @@ func TestContextualHelp(t *testing.T) {
 		{`SHOW PROCEDURES FROM ??`, `SHOW PROCEDURES`},
 		{`SHOW PROCEDURES FROM blah ??`, `SHOW PROCEDURES`},
 
+		{`SHOW PROTECTED ??`, `SHOW PROTECTED TIMESTAMPS`},
+		{`SHOW PROTECTED TIMESTAMPS ??`, `SHOW PROTECTED TIMESTAMPS`},
+
 		{`SHOW GRANTS ON ??`, `SHOW GRANTS`},
 		{`SHOW GRANTS ON foo FOR ??`, `SHOW GRANTS`},
 		{`SHOW GRANTS ON foo FOR bar ??`, `SHOW GRANTS`},
@@ -230,7 +230,7 @@ func (l *lexer) Lex(lval *sqlSymType) int {
 			}
 		case NOT:
 			switch nextToken.id {
-			case BETWEEN, IN, LIKE, ILIKE, SIMILAR:
+			case BETWEEN, IN, LIKE, ILIKE, SIMILAR, DEFERRABLE:
 				lval.id = NOT_LA
 			}
 		case GENERATED:
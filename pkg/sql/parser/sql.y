@@ -1015,7 +1015,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %token <str> LABEL LANGUAGE LAST LATERAL LATEST LC_CTYPE LC_COLLATE
 %token <str> LEADING LEASE LEAST LEAKPROOF LEFT LESS LEVEL LIKE LIMIT
 %token <str> LINESTRING LINESTRINGM LINESTRINGZ LINESTRINGZM
-%token <str> LIST LOCAL LOCALITY LOCALTIME LOCALTIMESTAMP LOCKED LOGICAL LOGICALLY LOGIN LOOKUP LOW LSHIFT
+%token <str> LIST LISTEN LOCAL LOCALITY LOCALTIME LOCALTIMESTAMP LOCKED LOGICAL LOGICALLY LOGIN LOOKUP LOW LSHIFT
 
 %token <str> MATCH MATERIALIZED MERGE MINVALUE MAXVALUE METHOD MINUTE MODIFYCLUSTERSETTING MODIFYSQLCLUSTERSETTING MODE MONTH MOVE
 %token <str> MULTILINESTRING MULTILINESTRINGM MULTILINESTRINGZ MULTILINESTRINGZM
@@ -1025,7 +1025,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %token <str> NAN NAME NAMES NATURAL NEG_INNER_PRODUCT NEVER NEW NEW_DB_NAME NEW_KMS NEXT NO NOBYPASSRLS NOCANCELQUERY NOCONTROLCHANGEFEED
 %token <str> NOCONTROLJOB NOCREATEDB NOCREATELOGIN NOCREATEROLE NODE NOLOGIN NOMODIFYCLUSTERSETTING NOREPLICATION
 %token <str> NOSQLLOGIN NO_INDEX_JOIN NO_ZIGZAG_JOIN NO_FULL_SCAN NONE NONVOTERS NORMAL NOT
-%token <str> NOTHING NOTHING_AFTER_RETURNING
+%token <str> NOTHING NOTHING_AFTER_RETURNING NOTIFY
 %token <str> NOTNULL
 %token <str> NOVIEWACTIVITY NOVIEWACTIVITYREDACTED NOVIEWCLUSTERSETTING NOWAIT NULL NULLIF NULLS NUMERIC
 
@@ -1035,7 +1035,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %token <str> PARALLEL PARENT PARTIAL PARTITION PARTITIONS PASSWORD PAUSE PAUSED PER PERMISSIVE PHYSICAL PLACEMENT PLACING
 %token <str> PLAN PLANS POINT POINTM POINTZ POINTZM POLICIES POLICY POLYGON POLYGONM POLYGONZ POLYGONZM
 %token <str> POSITION PRECEDING PRECISION PREPARE PREPARED PRESERVE PRIMARY PRIOR PRIORITY PRIVILEGES
-%token <str> PROCEDURAL PROCEDURE PROCEDURES PUBLIC PUBLICATION
+%token <str> PROCEDURAL PROCEDURE PROCEDURES PROTECTED PUBLIC PUBLICATION
 
 %token <str> QUERIES QUERY QUOTE
 
@@ -1051,11 +1051,11 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %token <str> SHARE SHARED SHOW SIMILAR SIMPLE SIZE SKIP SKIP_LOCALITIES_CHECK SKIP_MISSING_FOREIGN_KEYS
 %token <str> SKIP_MISSING_SEQUENCES SKIP_MISSING_SEQUENCE_OWNERS SKIP_MISSING_VIEWS SKIP_MISSING_UDFS SMALLINT SMALLSERIAL
 %token <str> SNAPSHOT SOME SPLIT SQL SQLLOGIN
-%token <str> STABLE START STATE STATEMENT STATISTICS STATUS STDIN STDOUT STOP STRAIGHT STREAM STRICT STRING STORAGE STORE STORED STORING SUBJECT SUBSTRING SUPER
+%token <str> STABLE START STATE STATEMENT STATISTICS STATUS STDIN STDOUT STOP STRAIGHT STREAM STRICT STRICT_WORD_SIMILAR STRICT_WORD_SIMILAR_COMMUTED STRING STORAGE STORE STORED STORING SUBJECT SUBSTRING SUPER
 %token <str> SUPPORT SURVIVE SURVIVAL SYMMETRIC SYNTAX SYSTEM SQRT SUBSCRIPTION STATEMENTS
 
 %token <str> TABLE TABLES TABLESPACE TEMP TEMPLATE TEMPORARY TENANT TENANT_NAME TENANTS TESTING_RELOCATE TEXT THEN
-%token <str> TIES TIME TIMETZ TIMESTAMP TIMESTAMPTZ TO THROTTLING TRAILING TRACE
+%token <str> TIES TIME TIMETZ TIMESTAMP TIMESTAMPS TIMESTAMPTZ TO THROTTLING TRAILING TRACE
 %token <str> TRANSACTION TRANSACTIONS TRANSFER TRANSFORM TREAT TRIGGER TRIGGERS TRIM TRUE
 %token <str> TRUNCATE TRUSTED TYPE TYPES
 %token <str> TRACING
@@ -1067,7 +1067,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %token <str> VIEWCLUSTERMETADATA VIEWCLUSTERSETTING VIRTUAL VISIBLE INVISIBLE VISIBILITY VOLATILE VOTERS
 %token <str> VIRTUAL_CLUSTER_NAME VIRTUAL_CLUSTER
 
-%token <str> WHEN WHERE WINDOW WITH WITHIN WITHOUT WORK WRITE
+%token <str> WHEN WHERE WINDOW WITH WITHIN WITHOUT WORD_SIMILAR WORD_SIMILAR_COMMUTED WORK WRITE
 
 %token <str> YEAR
 
@@ -1080,7 +1080,9 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 //
 // - NOT_LA exists so that productions such as NOT LIKE can be given the same
 // precedence as LIKE; otherwise they'd effectively have the same precedence as
-// NOT, at least with respect to their left-hand subexpression.
+// NOT, at least with respect to their left-hand subexpression. NOT_LA is also
+// produced for NOT DEFERRABLE, so that it can follow a table constraint that
+// may otherwise be followed by NOT VALID.
 // - WITH_LA is needed to make the grammar LALR(1).
 // - GENERATED_ALWAYS is needed to support the Postgres syntax for computed
 // columns along with our family related extensions (CREATE FAMILY/CREATE FAMILY
@@ -1279,6 +1281,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %type <tree.Statement> drop_ddl_stmt
 %type <tree.Statement> drop_database_stmt
 %type <tree.Statement> drop_external_connection_stmt
+%type <tree.Statement> alter_external_connection_stmt
 %type <tree.Statement> drop_index_stmt
 %type <tree.Statement> drop_role_stmt
 %type <tree.Statement> drop_schema_stmt
@@ -1387,6 +1390,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 %type <tree.Statement> show_zone_stmt
 %type <tree.Statement> show_schedules_stmt
 %type <tree.Statement> show_full_scans_stmt
+%type <tree.Statement> show_protected_timestamps_stmt
 %type <tree.Statement> show_completions_stmt
 %type <tree.Statement> show_logical_replication_jobs_stmt opt_show_logical_replication_jobs_options show_logical_replication_jobs_options
 %type <tree.Statement> show_policies_stmt
@@ -1398,6 +1402,8 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 
 %type <tree.Statement> transaction_stmt legacy_transaction_stmt legacy_begin_stmt legacy_end_stmt
 %type <tree.Statement> truncate_stmt
+%type <tree.Statement> listen_stmt
+%type <tree.Statement> notify_stmt
 %type <tree.Statement> unlisten_stmt
 %type <tree.Statement> update_stmt
 %type <tree.Statement> upsert_stmt
@@ -1833,7 +1839,7 @@ func (u *sqlSymUnion) indexType() tree.IndexType {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS GROUPS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH AT_AT DISTANCE COS_DISTANCE NEG_INNER_PRODUCT // multi-character ops
+%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH AT_AT DISTANCE COS_DISTANCE NEG_INNER_PRODUCT WORD_SIMILAR WORD_SIMILAR_COMMUTED STRICT_WORD_SIMILAR STRICT_WORD_SIMILAR_COMMUTED // multi-character ops
 %left      '|'
 %left      '#'
 %left      '&'
@@ -1902,6 +1908,8 @@ stmt_without_legacy_transaction:
 | fetch_cursor_stmt          // EXTEND WITH HELP: FETCH
 | move_cursor_stmt           // EXTEND WITH HELP: MOVE
 | reindex_stmt
+| listen_stmt
+| notify_stmt
 | unlisten_stmt
 | show_commit_timestamp_stmt // EXTEND WITH HELP: SHOW COMMIT TIMESTAMP
 
@@ -1931,6 +1939,7 @@ alter_ddl_stmt:
 | alter_func_stmt               // EXTEND WITH HELP: ALTER FUNCTION
 | alter_proc_stmt               // EXTEND WITH HELP: ALTER PROCEDURE
 | alter_backup_schedule  // EXTEND WITH HELP: ALTER BACKUP SCHEDULE
+| alter_external_connection_stmt // EXTEND WITH HELP: ALTER EXTERNAL CONNECTION
 | alter_policy_stmt             // EXTEND WITH HELP: ALTER POLICY
 
 // %Help: ALTER TABLE - change the definition of a table
@@ -3826,6 +3835,28 @@ drop_external_connection_stmt:
 	}
 	| DROP EXTERNAL CONNECTION error // SHOW HELP: DROP EXTERNAL CONNECTION
 
+// %Help: ALTER EXTERNAL CONNECTION - change the endpoint of an external connection
+// %Category: Misc
+// %Text:
+// ALTER EXTERNAL CONNECTION <name> AS <endpoint>
+//
+// Name:
+//   Name of an existing external connection.
+//
+// Endpoint:
+//   New endpoint of the resource that the external connection represents.
+//   It must be of the same type as the current endpoint.
+// %SeeAlso: CREATE EXTERNAL CONNECTION, DROP EXTERNAL CONNECTION
+alter_external_connection_stmt:
+	ALTER EXTERNAL CONNECTION string_or_placeholder AS string_or_placeholder
+	{
+		$$.val = &tree.AlterExternalConnection{
+			ConnectionLabel: $4.expr(),
+			As:              $6.expr(),
+		}
+	}
+	| ALTER EXTERNAL CONNECTION error // SHOW HELP: ALTER EXTERNAL CONNECTION
+
 // %Help: RESTORE - restore data from external storage
 // %Category: CCL
 // %Text:
@@ -8095,7 +8126,8 @@ zone_value:
 // SHOW STATISTICS, SHOW SYNTAX, SHOW TABLES, SHOW TRACE, SHOW TRANSACTION,
 // SHOW TRANSACTIONS, SHOW TRANSFER, SHOW TYPES, SHOW USERS, SHOW LAST QUERY STATISTICS,
 // SHOW SCHEDULES, SHOW LOCALITY, SHOW ZONE CONFIGURATION, SHOW COMMIT TIMESTAMP,
-// SHOW FULL TABLE SCANS, SHOW CREATE EXTERNAL CONNECTIONS, SHOW EXTERNAL CONNECTIONS
+// SHOW FULL TABLE SCANS, SHOW CREATE EXTERNAL CONNECTIONS, SHOW EXTERNAL CONNECTIONS,
+// SHOW PROTECTED TIMESTAMPS
 show_stmt:
   show_backup_stmt           // EXTEND WITH HELP: SHOW BACKUP
 | show_columns_stmt          // EXTEND WITH HELP: SHOW COLUMNS
@@ -8146,6 +8178,7 @@ show_stmt:
 | SHOW error                 // SHOW HELP: SHOW
 | show_last_query_stats_stmt
 | show_full_scans_stmt
+| show_protected_timestamps_stmt // EXTEND WITH HELP: SHOW PROTECTED TIMESTAMPS
 | show_default_privileges_stmt // EXTEND WITH HELP: SHOW DEFAULT PRIVILEGES
 | show_completions_stmt
 
@@ -9961,6 +9994,17 @@ show_full_scans_stmt:
     $$.val = &tree.ShowFullTableScans{}
   }
 
+// %Help: SHOW PROTECTED TIMESTAMPS - list protected timestamp records
+// %Category: Misc
+// %Text: SHOW PROTECTED TIMESTAMPS
+// %SeeAlso: SHOW JOBS, SHOW ZONE CONFIGURATION
+show_protected_timestamps_stmt:
+  SHOW PROTECTED TIMESTAMPS
+  {
+    $$.val = &tree.ShowProtectedTimestamps{}
+  }
+| SHOW PROTECTED error // SHOW HELP: SHOW PROTECTED TIMESTAMPS
+
 opt_on_targets_roles:
   ON targets_roles
   {
@@ -11312,13 +11356,22 @@ create_as_col_qualification_elem:
     }
   }
 
+// opt_deferrable accepts the constraint timing clauses that match the only
+// behavior we support, which is checking the constraint immediately at the end
+// of each statement. Deferrable constraints are not supported.
 opt_deferrable:
   /* EMPTY */ { /* no error */ }
+| NOT_LA DEFERRABLE { /* no error */ }
+| NOT_LA DEFERRABLE INITIALLY IMMEDIATE { /* no error */ }
+| NOT_LA DEFERRABLE INITIALLY DEFERRED
+  {
+    return setErr(sqllex, pgerror.New(pgcode.InvalidTableDefinition, "constraint declared INITIALLY DEFERRED must be DEFERRABLE"))
+  }
 | DEFERRABLE { return unimplementedWithIssueDetail(sqllex, 31632, "deferrable") }
 | DEFERRABLE INITIALLY DEFERRED { return unimplementedWithIssueDetail(sqllex, 31632, "def initially deferred") }
 | DEFERRABLE INITIALLY IMMEDIATE { return unimplementedWithIssueDetail(sqllex, 31632, "def initially immediate") }
 | INITIALLY DEFERRED { return unimplementedWithIssueDetail(sqllex, 31632, "initially deferred") }
-| INITIALLY IMMEDIATE { return unimplementedWithIssueDetail(sqllex, 31632, "initially immediate") }
+| INITIALLY IMMEDIATE { /* no error */ }
 
 storing:
   COVERING
@@ -13061,7 +13114,7 @@ transaction_deferrable_mode:
   {
     $$.val = tree.Deferrable
   }
-| NOT DEFERRABLE
+| NOT_LA DEFERRABLE
   {
     $$.val = tree.NotDeferrable
   }
@@ -14220,9 +14273,18 @@ group_by_list:
 // rather than reducing the conflicting unreserved_keyword rule.
 group_by_item:
   a_expr { $$.val = $1.expr() }
-| ROLLUP '(' error { return unimplementedWithIssueDetail(sqllex, 46280, "rollup") }
-| CUBE '(' error { return unimplementedWithIssueDetail(sqllex, 46280, "cube") }
-| GROUPING SETS error { return unimplementedWithIssueDetail(sqllex, 46280, "grouping sets") }
+| ROLLUP '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingSet{Type: tree.RollupType, Exprs: $3.exprs()}
+  }
+| CUBE '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingSet{Type: tree.CubeType, Exprs: $3.exprs()}
+  }
+| GROUPING SETS '(' group_by_list ')'
+  {
+    $$.val = &tree.GroupingSet{Type: tree.GroupingSetsType, Exprs: $4.exprs()}
+  }
 
 having_clause:
   HAVING a_expr
@@ -14806,6 +14868,25 @@ relation_expr_list:
     $$.val = append($1.tableNames(), name)
   }
 
+// LISTEN
+listen_stmt:
+  LISTEN type_name
+  {
+    $$.val = &tree.Listen{ChannelName: $2.unresolvedObjectName()}
+  }
+
+// NOTIFY
+notify_stmt:
+  NOTIFY type_name
+  {
+    $$.val = &tree.Notify{ChannelName: $2.unresolvedObjectName()}
+  }
+| NOTIFY type_name ',' SCONST
+  {
+    payload := $4
+    $$.val = &tree.Notify{ChannelName: $2.unresolvedObjectName(), Payload: &payload}
+  }
+
 // UNLISTEN
 unlisten_stmt:
    UNLISTEN type_name
@@ -15715,6 +15796,22 @@ a_expr:
   {
     $$.val = &tree.ComparisonExpr{Operator: treecmp.MakeComparisonOperator(treecmp.TSMatches), Left: $1.expr(), Right: $3.expr()}
   }
+| a_expr WORD_SIMILAR a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: treecmp.MakeComparisonOperator(treecmp.WordSimilar), Left: $1.expr(), Right: $3.expr()}
+  }
+| a_expr WORD_SIMILAR_COMMUTED a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: treecmp.MakeComparisonOperator(treecmp.WordSimilarCommuted), Left: $1.expr(), Right: $3.expr()}
+  }
+| a_expr STRICT_WORD_SIMILAR a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: treecmp.MakeComparisonOperator(treecmp.StrictWordSimilar), Left: $1.expr(), Right: $3.expr()}
+  }
+| a_expr STRICT_WORD_SIMILAR_COMMUTED a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: treecmp.MakeComparisonOperator(treecmp.StrictWordSimilarCommuted), Left: $1.expr(), Right: $3.expr()}
+  }
 | a_expr DISTANCE a_expr
   {
     $$.val = &tree.BinaryExpr{Operator: treebin.MakeBinaryOperator(treebin.Distance), Left: $1.expr(), Right: $3.expr()}
@@ -16281,7 +16378,10 @@ d_expr:
   {
     $$.val = $2.expr()
   }
-| GROUPING '(' expr_list ')' { return unimplemented(sqllex, "d_expr grouping") }
+| GROUPING '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingExpr{Exprs: $3.exprs()}
+  }
 
 func_application:
   func_application_name '(' ')'
@@ -16939,6 +17039,10 @@ all_op:
 | NOT_REGIMATCH { $$.val = treecmp.MakeComparisonOperator(treecmp.NotRegIMatch) }
 | AND_AND { $$.val = treecmp.MakeComparisonOperator(treecmp.Overlaps) }
 | AT_AT { $$.val = treecmp.MakeComparisonOperator(treecmp.TSMatches) }
+| WORD_SIMILAR { $$.val = treecmp.MakeComparisonOperator(treecmp.WordSimilar) }
+| WORD_SIMILAR_COMMUTED { $$.val = treecmp.MakeComparisonOperator(treecmp.WordSimilarCommuted) }
+| STRICT_WORD_SIMILAR { $$.val = treecmp.MakeComparisonOperator(treecmp.StrictWordSimilar) }
+| STRICT_WORD_SIMILAR_COMMUTED { $$.val = treecmp.MakeComparisonOperator(treecmp.StrictWordSimilarCommuted) }
 | DISTANCE { $$.val = treebin.MakeBinaryOperator(treebin.Distance) }
 | COS_DISTANCE { $$.val = treebin.MakeBinaryOperator(treebin.CosDistance) }
 | NEG_INNER_PRODUCT { $$.val = treebin.MakeBinaryOperator(treebin.NegInnerProduct) }
@@ -18077,6 +18181,7 @@ unreserved_keyword:
 | LINESTRINGZ
 | LINESTRINGZM
 | LIST
+| LISTEN
 | LOCAL
 | LOCKED
 | LOGICAL
@@ -18119,6 +18224,7 @@ unreserved_keyword:
 | NO
 | NORMAL
 | NOTHING
+| NOTIFY
 | NO_INDEX_JOIN
 | NO_ZIGZAG_JOIN
 | NO_FULL_SCAN
@@ -18187,6 +18293,7 @@ unreserved_keyword:
 | PRIVILEGES
 | PROCEDURE
 | PROCEDURES
+| PROTECTED
 | PUBLIC
 | PUBLICATION
 | QUERIES
@@ -18314,6 +18421,7 @@ unreserved_keyword:
 | TESTING_RELOCATE
 | TEXT
 | TIES
+| TIMESTAMPS
 | TRACE
 | TRACING
 | TRANSACTION
@@ -18644,6 +18752,7 @@ bare_label_keywords:
 | LINESTRINGZ
 | LINESTRINGZM
 | LIST
+| LISTEN
 | LOCAL
 | LOCALITY
 | LOCALTIME
@@ -18702,6 +18811,7 @@ bare_label_keywords:
 | NOSQLLOGIN
 | NOT
 | NOTHING
+| NOTIFY
 | NOTHING_AFTER_RETURNING
 | NOVIEWACTIVITY
 | NOVIEWACTIVITYREDACTED
@@ -18768,6 +18878,7 @@ bare_label_keywords:
 | PRIVILEGES
 | PROCEDURE
 | PROCEDURES
+| PROTECTED
 | PUBLIC
 | PUBLICATION
 | QUERIES
@@ -18912,6 +19023,7 @@ bare_label_keywords:
 | TIES
 | TIME
 | TIMESTAMP
+| TIMESTAMPS
 | TIMESTAMPTZ
 | TIMETZ
 | TRACE
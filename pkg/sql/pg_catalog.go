@@ -886,10 +886,9 @@ https://www.postgresql.org/docs/9.5/catalog-pg-collation.html`,
 					// required by LC_COLLATE and LC_CTYPE.
 					tree.DNull, // collcollate
 					tree.DNull, // collctype
-					// These columns were automatically created by pg_catalog_test's missing column generator.
-					tree.DNull, // collprovider
+					tree.NewDString(collatedstring.Provider(collName)), // collprovider
 					tree.DNull, // collversion
-					tree.DNull, // collisdeterministic
+					tree.MakeDBool(tree.DBool(collatedstring.IsDeterministic(collName))), // collisdeterministic
 				)
 			}
 			for _, tag := range collatedstring.Supported() {
@@ -2473,8 +2472,8 @@ https://www.postgresql.org/docs/9.6/view-pg-prepared-xacts.html`,
 // pgCatalogPreparedStatementsTable implements the pg_prepared_statements table.
 // The statement field differs in that it uses the parsed version
 // of the PREPARE statement.
-// The parameter_types field differs from postgres as the type names in
-// cockroach are slightly different.
+// The parameter_types and result_types fields differ from postgres as the type
+// names in cockroach are slightly different.
 var pgCatalogPreparedStatementsTable = virtualSchemaTable{
 	comment: `prepared statements
 https://www.postgresql.org/docs/9.6/view-pg-prepared-statements.html`,
@@ -2501,6 +2500,18 @@ https://www.postgresql.org/docs/9.6/view-pg-prepared-statements.html`,
 				argumentsStr = fmt.Sprintf(" (%s)", strings.Join(paramNames, ", "))
 			}
 
+			// Statements that return no rows have NULL result types.
+			resultTypes := tree.DNull
+			if len(stmt.Columns) > 0 {
+				arr := tree.NewDArray(types.RegType)
+				arr.Array = make(tree.Datums, len(stmt.Columns))
+				for i := range stmt.Columns {
+					typ := stmt.Columns[i].Typ
+					arr.Array[i] = tree.NewDOidWithTypeAndName(typ.Oid(), typ, typ.SQLStandardName())
+				}
+				resultTypes = arr
+			}
+
 			fromSQL := tree.DBoolFalse
 			if stmt.origin == PreparedStatementOriginSQL {
 				fromSQL = tree.DBoolTrue
@@ -2515,7 +2526,10 @@ https://www.postgresql.org/docs/9.6/view-pg-prepared-statements.html`,
 				tree.NewDString(fmt.Sprintf("PREPARE %s%s AS %s", name, argumentsStr, stmt.SQL)),
 				ts,
 				paramTypes,
+				resultTypes,
 				fromSQL,
+				tree.NewDInt(tree.DInt(stmt.GenericPlans)),
+				tree.NewDInt(tree.DInt(stmt.CustomPlans)),
 			); err != nil {
 				return err
 			}
@@ -4458,12 +4472,38 @@ var pgCatalogGroupTable = virtualSchemaTable{
 }
 
 var pgCatalogLargeobjectMetadataTable = virtualSchemaTable{
-	comment: "pg_largeobject_metadata was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogLargeobjectMetadata,
+	comment: `large object metadata
+https://www.postgresql.org/docs/16/catalog-pg-largeobject-metadata.html`,
+	schema: vtable.PgCatalogLargeobjectMetadata,
 	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if !p.IsActive(ctx, clusterversion.V25_1_LargeObjectsTable) {
+			// This check can be removed when mixed-version support with v24.3 is
+			// no longer necessary.
+			return nil
+		}
+		rows, err := p.InternalSQLTxn().QueryBufferedEx(
+			ctx,
+			"select-large-object-metadata",
+			p.Txn(),
+			sessiondata.NodeUserSessionDataOverride,
+			`SELECT loid, owner FROM system.large_object_metadata`,
+		)
+		if err != nil {
+			return err
+		}
+		h := makeOidHasher()
+		for _, row := range rows {
+			owner := username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[1])))
+			if err := addRow(
+				row[0],           // oid
+				h.UserOid(owner), // lomowner
+				tree.DNull,       // lomacl
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogReplicationSlotsTable = virtualSchemaTable{
@@ -4634,13 +4674,55 @@ var pgCatalogStatSubscriptionTable = virtualSchemaTable{
 	unimplemented: true,
 }
 
+// pgCatalogLargeobjectTable implements the pg_largeobject table. Postgres
+// only allows superusers to read pg_largeobject; here, users that are not
+// admins only see the pages of the large objects that they own.
 var pgCatalogLargeobjectTable = virtualSchemaTable{
-	comment: "pg_largeobject was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogLargeobject,
+	comment: `large object contents
+https://www.postgresql.org/docs/16/catalog-pg-largeobject.html`,
+	schema: vtable.PgCatalogLargeobject,
 	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if !p.IsActive(ctx, clusterversion.V25_1_LargeObjectsTable) {
+			// This check can be removed when mixed-version support with v24.3 is
+			// no longer necessary.
+			return nil
+		}
+		isAdmin, err := p.HasAdminRole(ctx)
+		if err != nil {
+			return err
+		}
+		var memberOf map[username.SQLUsername]bool
+		if !isAdmin {
+			if memberOf, err = p.MemberOfWithAdminOption(ctx, p.User()); err != nil {
+				return err
+			}
+		}
+		rows, err := p.InternalSQLTxn().QueryBufferedEx(
+			ctx,
+			"select-large-objects",
+			p.Txn(),
+			sessiondata.NodeUserSessionDataOverride,
+			`SELECT o.loid, o.pageno, o.data, m.owner
+FROM system.large_objects AS o JOIN system.large_object_metadata AS m ON o.loid = m.loid`,
+		)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			owner := username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[3])))
+			if _, ok := memberOf[owner]; !isAdmin && owner != p.User() && !ok {
+				continue
+			}
+			if err := addRow(
+				row[0], // loid
+				row[1], // pageno
+				row[2], // data
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogReplicationOriginStatusTable = virtualSchemaTable{
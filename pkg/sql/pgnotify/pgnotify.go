@@ -0,0 +1,218 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package pgnotify implements the fan-out of asynchronous notifications
+// produced by NOTIFY to the sessions that executed LISTEN on the matching
+// channel. Notifications are written to system.notifications by the sending
+// transaction, and every SQL instance watches that table to deliver them to
+// its own sessions.
+package pgnotify
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// MaxPayloadLength is the maximum length, in bytes, of a notification
+// payload, exclusive. It matches the limit imposed by Postgres.
+const MaxPayloadLength = 8000
+
+// MaxChannelLength is the maximum length, in bytes, of a channel name,
+// exclusive. It matches NAMEDATALEN in Postgres.
+const MaxChannelLength = 64
+
+// maxPendingNotifications is the maximum number of notifications that can be
+// queued for a single session before new notifications start being dropped.
+const maxPendingNotifications = 4096
+
+// Notification is a single asynchronous notification, as delivered to the
+// client in a NotificationResponse message.
+type Notification struct {
+	// Channel is the name of the channel the notification was sent on.
+	Channel string
+	// Payload is the (possibly empty) payload string.
+	Payload string
+	// PID identifies the session that sent the notification. It is the same
+	// value that is returned by pg_backend_pid() in that session.
+	PID int32
+}
+
+// Registry keeps track of the channels each session is listening on and
+// fans out notifications to them. A Registry is shared by all sessions of a
+// SQL instance.
+type Registry struct {
+	mu struct {
+		syncutil.Mutex
+		// channels maps a channel name to the set of subscriptions listening
+		// on it.
+		channels map[string]map[*Subscription]struct{}
+	}
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	r := &Registry{}
+	r.mu.channels = make(map[string]map[*Subscription]struct{})
+	return r
+}
+
+// Subscribe creates a new Subscription through which a session can listen
+// on channels. The subscription must be closed once the session ends.
+//
+// If onNotify is not nil, it is called whenever a notification is queued
+// into a previously empty subscription, so that an idle session can wake up
+// and forward it to the client. It must not block.
+func (r *Registry) Subscribe(onNotify func()) *Subscription {
+	s := &Subscription{registry: r, onNotify: onNotify}
+	s.mu.channels = make(map[string]struct{})
+	return s
+}
+
+// Notify delivers the notification to every subscription currently
+// listening on its channel, including the one belonging to the sender.
+//
+// Once the registry is started (see Start), Notify is called for every row
+// written to system.notifications by any SQL instance.
+func (r *Registry) Notify(n Notification) {
+	// The subscriptions' onNotify callbacks are invoked without holding the
+	// registry's lock, so that they can't block LISTEN and UNLISTEN in other
+	// sessions.
+	r.mu.Lock()
+	listeners := make([]*Subscription, 0, len(r.mu.channels[n.Channel]))
+	for s := range r.mu.channels[n.Channel] {
+		listeners = append(listeners, s)
+	}
+	r.mu.Unlock()
+	for _, s := range listeners {
+		s.enqueue(n)
+	}
+}
+
+// NumChannels returns the number of channels with at least one listener.
+func (r *Registry) NumChannels() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.mu.channels)
+}
+
+func (r *Registry) addListener(channel string, s *Subscription) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	listeners, ok := r.mu.channels[channel]
+	if !ok {
+		listeners = make(map[*Subscription]struct{})
+		r.mu.channels[channel] = listeners
+	}
+	listeners[s] = struct{}{}
+}
+
+func (r *Registry) removeListener(channel string, s *Subscription) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	listeners, ok := r.mu.channels[channel]
+	if !ok {
+		return
+	}
+	delete(listeners, s)
+	if len(listeners) == 0 {
+		delete(r.mu.channels, channel)
+	}
+}
+
+// Subscription is the per-session view of a Registry. It tracks the channels
+// the session is listening on and queues the notifications delivered to it
+// until the session is ready to forward them to the client.
+type Subscription struct {
+	registry *Registry
+	onNotify func()
+
+	mu struct {
+		syncutil.Mutex
+		channels map[string]struct{}
+		pending  []Notification
+		// dropped is the number of notifications that were discarded since
+		// the last call to Drain because the queue was full.
+		dropped int
+	}
+}
+
+// Listen starts listening on the given channel. Listening on a channel that
+// the subscription is already listening on is a no-op.
+func (s *Subscription) Listen(channel string) {
+	s.mu.Lock()
+	_, ok := s.mu.channels[channel]
+	s.mu.channels[channel] = struct{}{}
+	s.mu.Unlock()
+	if !ok {
+		s.registry.addListener(channel, s)
+	}
+}
+
+// Unlisten stops listening on the given channel. Notifications for the
+// channel that have already been queued are still delivered.
+func (s *Subscription) Unlisten(channel string) {
+	s.mu.Lock()
+	_, ok := s.mu.channels[channel]
+	delete(s.mu.channels, channel)
+	s.mu.Unlock()
+	if ok {
+		s.registry.removeListener(channel, s)
+	}
+}
+
+// UnlistenAll stops listening on all channels.
+func (s *Subscription) UnlistenAll() {
+	for _, channel := range s.Channels() {
+		s.Unlisten(channel)
+	}
+}
+
+// Channels returns the sorted list of channels the subscription is listening
+// on.
+func (s *Subscription) Channels() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channels := make([]string, 0, len(s.mu.channels))
+	for channel := range s.mu.channels {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// Drain returns the notifications queued since the last call, in the order
+// in which they were sent, along with the number of notifications that had to
+// be dropped because the queue was full.
+func (s *Subscription) Drain() (_ []Notification, dropped int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := s.mu.pending
+	dropped = s.mu.dropped
+	s.mu.pending = nil
+	s.mu.dropped = 0
+	return pending, dropped
+}
+
+// Close stops listening on all channels and discards any queued
+// notifications.
+func (s *Subscription) Close() {
+	s.UnlistenAll()
+	_, _ = s.Drain()
+}
+
+func (s *Subscription) enqueue(n Notification) {
+	s.mu.Lock()
+	wasEmpty := len(s.mu.pending) == 0
+	if len(s.mu.pending) >= maxPendingNotifications {
+		s.mu.dropped++
+	} else {
+		s.mu.pending = append(s.mu.pending, n)
+	}
+	s.mu.Unlock()
+	if wasEmpty && s.onNotify != nil {
+		s.onNotify()
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgnotify
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlinstance"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	r := NewRegistry()
+	a := r.Subscribe(nil /* onNotify */)
+	b := r.Subscribe(nil /* onNotify */)
+
+	a.Listen("foo")
+	a.Listen("bar")
+	b.Listen("foo")
+	// Listening twice is a no-op.
+	b.Listen("foo")
+	require.Equal(t, []string{"bar", "foo"}, a.Channels())
+	require.Equal(t, []string{"foo"}, b.Channels())
+	require.Equal(t, 2, r.NumChannels())
+
+	r.Notify(Notification{Channel: "foo", Payload: "1", PID: 10})
+	r.Notify(Notification{Channel: "bar", Payload: "2", PID: 10})
+	r.Notify(Notification{Channel: "baz", Payload: "3", PID: 10})
+
+	pending, dropped := a.Drain()
+	require.Zero(t, dropped)
+	require.Equal(t, []Notification{
+		{Channel: "foo", Payload: "1", PID: 10},
+		{Channel: "bar", Payload: "2", PID: 10},
+	}, pending)
+	pending, _ = b.Drain()
+	require.Equal(t, []Notification{{Channel: "foo", Payload: "1", PID: 10}}, pending)
+
+	// Draining again returns nothing.
+	pending, _ = a.Drain()
+	require.Empty(t, pending)
+
+	a.Unlisten("foo")
+	r.Notify(Notification{Channel: "foo", Payload: "4", PID: 11})
+	pending, _ = a.Drain()
+	require.Empty(t, pending)
+	pending, _ = b.Drain()
+	require.Len(t, pending, 1)
+
+	a.UnlistenAll()
+	b.Close()
+	require.Empty(t, a.Channels())
+	require.Zero(t, r.NumChannels())
+}
+
+func TestSubscriptionOverflow(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	r := NewRegistry()
+	var wakeups int
+	s := r.Subscribe(func() { wakeups++ })
+	defer s.Close()
+	s.Listen("foo")
+
+	for i := 0; i < maxPendingNotifications+5; i++ {
+		r.Notify(Notification{Channel: "foo"})
+	}
+	pending, dropped := s.Drain()
+	require.Len(t, pending, maxPendingNotifications)
+	require.Equal(t, 5, dropped)
+	// Only the first notification queued into the empty subscription results
+	// in a wakeup.
+	require.Equal(t, 1, wakeups)
+
+	_, dropped = s.Drain()
+	require.Zero(t, dropped)
+}
+
+func TestNotifyCallbackCanUseRegistry(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	r := NewRegistry()
+	var a *Subscription
+	// The callback runs without the registry's lock held, so it can change
+	// the subscriptions of the registry.
+	a = r.Subscribe(func() { a.Listen("bar") })
+	defer a.Close()
+	a.Listen("foo")
+
+	r.Notify(Notification{Channel: "foo", Payload: "1"})
+	require.Equal(t, []string{"bar", "foo"}, a.Channels())
+	pending, _ := a.Drain()
+	require.Equal(t, []Notification{{Channel: "foo", Payload: "1"}}, pending)
+}
+
+func TestRunsCleanup(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	instances := func(ids ...base.SQLInstanceID) []sqlinstance.InstanceInfo {
+		var res []sqlinstance.InstanceInfo
+		for _, id := range ids {
+			res = append(res, sqlinstance.InstanceInfo{InstanceID: id})
+		}
+		return res
+	}
+	// Only the live instance with the lowest ID deletes expired notifications.
+	require.True(t, runsCleanup(2, instances(3, 2, 5)))
+	require.False(t, runsCleanup(3, instances(3, 2, 5)))
+	// An instance that is not among the live instances yet only runs the
+	// cleanup if no live instance has a lower ID.
+	require.True(t, runsCleanup(1, instances(3, 2, 5)))
+	require.False(t, runsCleanup(4, instances(3, 2, 5)))
+	require.True(t, runsCleanup(4, nil))
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgnotify
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/valueside"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlinstance"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/errors"
+)
+
+// Retention is how long rows are kept in system.notifications. Rows are
+// delivered by the rangefeed as soon as the sending transaction commits, so
+// they only need to outlive transient rangefeed restarts.
+const Retention = time.Minute
+
+// versionPollInterval is how often Start checks whether the cluster has been
+// upgraded to a version that has the system.notifications table.
+const versionPollInterval = 10 * time.Second
+
+// Start makes the registry deliver the notifications written to
+// system.notifications by any SQL instance. It watches the table with a
+// rangefeed and, if this is the live SQL instance with the lowest ID,
+// periodically deletes rows older than Retention.
+//
+// Until the cluster version that adds the table is active, Start waits in the
+// background and notifications are only delivered to the sessions of the SQL
+// instance that sent them (see Registry.Notify).
+//
+// The rangefeed delivers each row at least once; notifications can be
+// delivered twice to a listener if the rangefeed restarts.
+func (r *Registry) Start(
+	ctx context.Context,
+	stopper *stop.Stopper,
+	codec keys.SQLCodec,
+	st *cluster.Settings,
+	db isql.DB,
+	rangeFeedFactory *rangefeed.Factory,
+	sysTableResolver catalog.SystemTableIDResolver,
+	sqlIDContainer *base.SQLIDContainer,
+	instances sqlinstance.AddressResolver,
+) error {
+	return stopper.RunAsyncTask(ctx, "notifications-watcher", func(ctx context.Context) {
+		ctx, cancel := stopper.WithCancelOnQuiesce(ctx)
+		defer cancel()
+
+		var timer timeutil.Timer
+		defer timer.Stop()
+		for !st.Version.IsActive(ctx, clusterversion.V25_1_NotificationsTable) {
+			timer.Reset(versionPollInterval)
+			select {
+			case <-timer.C:
+				timer.Read = true
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		tableID, err := sysTableResolver.LookupSystemTableID(ctx, systemschema.NotificationsTable.GetName())
+		if err == nil && tableID == descpb.InvalidID {
+			err = errors.AssertionFailedf("system.notifications does not exist")
+		}
+		if err != nil {
+			log.Warningf(ctx, "unable to watch system.notifications: %v", err)
+			return
+		}
+		feed, err := r.startRangeFeed(ctx, codec, db, rangeFeedFactory, tableID)
+		if err != nil {
+			log.Warningf(ctx, "unable to watch system.notifications: %v", err)
+			return
+		}
+		defer feed.Close()
+
+		for {
+			timer.Reset(Retention)
+			select {
+			case <-timer.C:
+				timer.Read = true
+			case <-ctx.Done():
+				return
+			}
+			all, err := instances.GetAllInstances(ctx)
+			if err != nil {
+				log.Warningf(ctx, "unable to list SQL instances: %v", err)
+				continue
+			}
+			if !runsCleanup(sqlIDContainer.SQLInstanceID(), all) {
+				continue
+			}
+			if err := deleteExpiredNotifications(ctx, db); err != nil {
+				log.Warningf(ctx, "unable to delete expired notifications: %v", err)
+			}
+		}
+	})
+}
+
+// startRangeFeed starts a rangefeed on system.notifications that forwards
+// every inserted row to the registry's subscriptions.
+func (r *Registry) startRangeFeed(
+	ctx context.Context,
+	codec keys.SQLCodec,
+	db isql.DB,
+	rangeFeedFactory *rangefeed.Factory,
+	tableID descpb.ID,
+) (*rangefeed.RangeFeed, error) {
+	// Only watch the primary index; the secondary index on sent is only used
+	// to find the expired rows.
+	primaryIndexID := systemschema.NotificationsTable.GetPrimaryIndexID()
+	indexPrefix := codec.IndexPrefix(uint32(tableID), uint32(primaryIndexID))
+	indexSpan := roachpb.Span{
+		Key:    indexPrefix,
+		EndKey: indexPrefix.PrefixEnd(),
+	}
+	decoder := valueside.MakeDecoder(systemschema.NotificationsTable.PublicColumns())
+	var alloc tree.DatumAlloc
+	handleEvent := func(ctx context.Context, kv *kvpb.RangeFeedValue) {
+		if !kv.Value.IsPresent() {
+			// The row was deleted by deleteExpiredNotifications.
+			return
+		}
+		n, err := decodeNotification(&decoder, &alloc, kv.Value)
+		if err != nil {
+			log.Warningf(ctx, "failed to decode notification %v: %v", kv.Key, err)
+			return
+		}
+		r.Notify(n)
+	}
+	// Only notifications sent after the rangefeed starts are delivered, which
+	// matches Postgres: a session does not receive the notifications that were
+	// committed before it started listening.
+	return rangeFeedFactory.RangeFeed(
+		ctx,
+		"notifications-watcher",
+		[]roachpb.Span{indexSpan},
+		db.KV().Clock().Now(),
+		handleEvent,
+		rangefeed.WithSystemTablePriority(),
+	)
+}
+
+// decodeNotification decodes the value of a row of system.notifications.
+func decodeNotification(
+	decoder *valueside.Decoder, alloc *tree.DatumAlloc, value roachpb.Value,
+) (Notification, error) {
+	bytes, err := value.GetTuple()
+	if err != nil {
+		return Notification{}, err
+	}
+	datums, err := decoder.Decode(alloc, bytes)
+	if err != nil {
+		return Notification{}, err
+	}
+	// The key column (id) is not part of the value, and the other columns
+	// are NOT NULL.
+	channel, ok := datums[1].(*tree.DString)
+	if !ok {
+		return Notification{}, errors.AssertionFailedf("unexpected channel %v", datums[1])
+	}
+	payload, ok := datums[2].(*tree.DString)
+	if !ok {
+		return Notification{}, errors.AssertionFailedf("unexpected payload %v", datums[2])
+	}
+	pid, ok := datums[3].(*tree.DInt)
+	if !ok {
+		return Notification{}, errors.AssertionFailedf("unexpected pid %v", datums[3])
+	}
+	return Notification{Channel: string(*channel), Payload: string(*payload), PID: int32(*pid)}, nil
+}
+
+// runsCleanup returns whether the SQL instance with the given ID is the one
+// that deletes the expired notifications, which is the live instance with the
+// lowest ID. If this instance is not among the live instances yet, it still
+// runs the cleanup unless a live instance has a lower ID.
+func runsCleanup(id base.SQLInstanceID, instances []sqlinstance.InstanceInfo) bool {
+	for _, instance := range instances {
+		if instance.InstanceID < id {
+			return false
+		}
+	}
+	return true
+}
+
+// deleteExpiredNotifications deletes the rows of system.notifications that are
+// older than Retention. It is only run by one SQL instance at a time (see
+// runsCleanup), although concurrent runs are harmless.
+func deleteExpiredNotifications(ctx context.Context, db isql.DB) error {
+	_, err := db.Executor().ExecEx(
+		ctx, "delete-expired-notifications", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.public.notifications WHERE sent < $1`,
+		timeutil.Now().Add(-Retention),
+	)
+	return err
+}
@@ -104,6 +104,14 @@ var PLpgSQLConditionNameToCode = map[string][]string{
 	"invalid_xml_content":                        {"2200N"},
 	"invalid_xml_comment":                        {"2200S"},
 	"invalid_xml_processing_instruction":         {"2200T"},
+	"invalid_sql_json_subscript":                 {"22033"},
+	"more_than_one_sql_json_item":                {"22034"},
+	"non_numeric_sql_json_item":                  {"22036"},
+	"singleton_sql_json_item_required":           {"22038"},
+	"sql_json_array_not_found":                   {"22039"},
+	"sql_json_member_not_found":                  {"2203A"},
+	"sql_json_object_not_found":                  {"2203C"},
+	"sql_json_scalar_required":                   {"2203F"},
 	// Section: Class 23 - Integrity Constraint Violation
 	"integrity_constraint_violation": {"23000"},
 	"restrict_violation":             {"23001"},
@@ -1918,3 +1918,121 @@ func TestFailPrepareFailsTxn(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestListenNotify checks that notifications sent with NOTIFY are delivered
+// to the sessions listening on the channel, both when the listening session is
+// idle and when it's in the middle of a transaction.
+func TestListenNotify(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	srv := serverutils.StartServerOnly(t, base.TestServerArgs{})
+	defer srv.Stopper().Stop(ctx)
+	s := srv.ApplicationLayer()
+
+	pgURL, cleanup := s.PGUrl(t)
+	defer cleanup()
+
+	listener, err := pgx.Connect(ctx, pgURL.String())
+	require.NoError(t, err)
+	defer func() { _ = listener.Close(ctx) }()
+	notifier, err := pgx.Connect(ctx, pgURL.String())
+	require.NoError(t, err)
+	defer func() { _ = notifier.Close(ctx) }()
+
+	var notifierPID uint32
+	require.NoError(t, notifier.QueryRow(ctx, "SELECT pg_backend_pid()").Scan(&notifierPID))
+
+	_, err = listener.Exec(ctx, "LISTEN foo")
+	require.NoError(t, err)
+
+	// The listening session is idle, so the notification is delivered
+	// right away.
+	_, err = notifier.Exec(ctx, "NOTIFY foo, 'hello'")
+	require.NoError(t, err)
+	n, err := listener.WaitForNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "foo", n.Channel)
+	require.Equal(t, "hello", n.Payload)
+	require.Equal(t, notifierPID, n.PID)
+
+	// Notifications sent in a transaction are only delivered once it commits,
+	// and duplicates are folded.
+	tx, err := notifier.Begin(ctx)
+	require.NoError(t, err)
+	for _, stmt := range []string{"NOTIFY foo, 'a'", "NOTIFY foo, 'a'", "NOTIFY bar, 'b'", "NOTIFY foo"} {
+		_, err = tx.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tx.Commit(ctx))
+	n, err = listener.WaitForNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", n.Payload)
+	n, err = listener.WaitForNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "", n.Payload)
+
+	// Notifications from rolled back transactions are discarded.
+	tx, err = notifier.Begin(ctx)
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, "NOTIFY foo, 'rolled back'")
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback(ctx))
+
+	// A notification received while the listener is inside a transaction is
+	// delivered once the transaction ends.
+	tx, err = listener.Begin(ctx)
+	require.NoError(t, err)
+	_, err = notifier.Exec(ctx, "NOTIFY foo, 'after commit'")
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+	n, err = listener.WaitForNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "after commit", n.Payload)
+
+	// After UNLISTEN, nothing is delivered anymore.
+	_, err = listener.Exec(ctx, "UNLISTEN *")
+	require.NoError(t, err)
+	_, err = notifier.Exec(ctx, "NOTIFY foo, 'ignored'")
+	require.NoError(t, err)
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = listener.WaitForNotification(waitCtx)
+	require.Error(t, err)
+}
+
+// TestListenNotifyAcrossNodes checks that notifications are delivered to the
+// sessions listening on the channel regardless of the node they are connected
+// to.
+func TestListenNotifyAcrossNodes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	tc := serverutils.StartCluster(t, 2, base.TestClusterArgs{})
+	defer tc.Stopper().Stop(ctx)
+
+	connect := func(idx int) *pgx.Conn {
+		pgURL, cleanup := tc.ApplicationLayer(idx).PGUrl(t)
+		defer cleanup()
+		conn, err := pgx.Connect(ctx, pgURL.String())
+		require.NoError(t, err)
+		return conn
+	}
+	listener := connect(0)
+	defer func() { _ = listener.Close(ctx) }()
+	notifier := connect(1)
+	defer func() { _ = notifier.Close(ctx) }()
+
+	_, err := listener.Exec(ctx, "LISTEN foo")
+	require.NoError(t, err)
+	_, err = notifier.Exec(ctx, "SELECT pg_notify('foo', 'from n2')")
+	require.NoError(t, err)
+	n, err := listener.WaitForNotification(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "foo", n.Channel)
+	require.Equal(t, "from n2", n.Payload)
+}
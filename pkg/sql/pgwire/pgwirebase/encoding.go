@@ -40,6 +40,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/uint128"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
 	"github.com/cockroachdb/cockroach/pkg/util/vector"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 	"github.com/dustin/go-humanize"
@@ -886,6 +887,14 @@ func DecodeDatum(
 			return nil, err
 		}
 		return da.NewDName(tree.DString(bs)), nil
+	case oid.T_xml:
+		if err := validateStringBytes(b); err != nil {
+			return nil, err
+		}
+		if _, err := xmlutil.ParseContent(bs); err != nil {
+			return nil, err
+		}
+		return da.NewDString(tree.DString(bs)), nil
 	}
 
 	// Fallthrough case.
@@ -8,6 +8,7 @@ package sql
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
 	"github.com/cockroachdb/cockroach/pkg/settings"
@@ -35,6 +36,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
 	"github.com/cockroachdb/cockroach/pkg/util/errorutil"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 	"github.com/cockroachdb/cockroach/pkg/util/tracing"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
@@ -115,6 +117,17 @@ func (p *planner) prepareUsingOptimizer(
 		stmt.Prepared.Columns = colinfo.ExplainPlanColumns
 		return opc.flags, nil
 
+	case *tree.Explain:
+		if _, ok := t.Statement.(*tree.Execute); ok {
+			// EXPLAIN EXECUTE is rewritten to an EXPLAIN of the prepared statement
+			// when it is executed, so there is nothing to do during prepare.
+			if len(p.semaCtx.Placeholders.Types) != 0 {
+				return 0, errors.Errorf("%s does not support placeholders", stmt.AST.StatementTag())
+			}
+			stmt.Prepared.Columns = colinfo.ExplainPlanColumns
+			return opc.flags, nil
+		}
+
 	case *tree.ShowCommitTimestamp:
 		stmt.Prepared.Columns = colinfo.ShowCommitTimestampColumns
 		return opc.flags, nil
@@ -550,6 +563,9 @@ func (opc *optPlanningCtx) reuseMemo(cachedMemo *memo.Memo) (*memo.Memo, error)
 		// The query could have been already fully optimized in
 		// buildReusableMemo, in which case it is considered a "generic" plan.
 		opc.flags.Set(planFlagGeneric)
+		if prep := opc.p.stmt.Prepared; opc.allowMemoReuse && prep != nil {
+			prep.GenericPlans++
+		}
 		return cachedMemo, nil
 	}
 	f := opc.optimizer.Factory()
@@ -570,6 +586,7 @@ func (opc *optPlanningCtx) reuseMemo(cachedMemo *memo.Memo) (*memo.Memo, error)
 		costWithOptimizationCost := mem.RootExpr().(memo.RelExpr).Cost()
 		costWithOptimizationCost.Add(mem.OptimizationCost())
 		prep.Costs.AddCustom(costWithOptimizationCost)
+		prep.CustomPlans++
 	}
 	return mem, nil
 }
@@ -822,10 +839,21 @@ func (opc *optPlanningCtx) buildExecMemo(ctx context.Context) (_ *memo.Memo, _ e
 	// available.
 	f := opc.optimizer.Factory()
 	f.FoldingControl().AllowStableFolds()
+	// EXPLAIN (OPT, STATS) displays statistics about the work done while
+	// planning the explained statement.
+	var planningStats *xform.PlanningStats
+	var buildStart time.Time
+	if e, ok := opc.p.stmt.AST.(*tree.Explain); ok && e.Flags[tree.ExplainFlagStats] {
+		planningStats = opc.optimizer.CollectPlanningStats()
+		buildStart = timeutil.Now()
+	}
 	bld := optbuilder.New(ctx, &p.semaCtx, p.EvalContext(), opc.catalog, f, opc.p.stmt.AST)
 	if err := bld.Build(); err != nil {
 		return nil, err
 	}
+	if planningStats != nil {
+		planningStats.BuildTime = timeutil.Since(buildStart)
+	}
 
 	// For index recommendations, after building we must interrupt the flow to
 	// find potential index candidates in the memo.
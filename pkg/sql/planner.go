@@ -107,6 +107,9 @@ type extendedEvalContext struct {
 
 	// validateDbZoneConfig should the DB zone config on commit.
 	validateDbZoneConfig *bool
+
+	// notifications refers to the LISTEN/NOTIFY state of the session.
+	notifications *sessionNotifications
 }
 
 // copyFromExecCfg copies relevant fields from an ExecutorConfig.
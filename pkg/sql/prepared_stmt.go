@@ -67,6 +67,12 @@ type PreparedStatement struct {
 	// Costs tracks the costs of previously optimized custom and generic plans.
 	Costs planCosts
 
+	// GenericPlans and CustomPlans count the executions of this statement that
+	// used a generic plan and a custom plan, respectively.
+	// Used for reporting on `pg_prepared_statements`.
+	GenericPlans int64
+	CustomPlans  int64
+
 	// refCount keeps track of the number of references to this PreparedStatement.
 	// New references are registered through incRef().
 	// Once refCount hits 0 (through calls to decRef()), the following memAcc is
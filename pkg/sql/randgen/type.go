@@ -42,6 +42,8 @@ func init() {
 			// https://github.com/cockroachdb/cockroach/issues/55791 is fixed.
 		case oid.T_unknown, oid.T_anyelement, oid.T_trigger:
 			// Don't include these.
+		case oid.T_xml:
+			// Don't include XML, since random strings are not valid XML.
 		case oid.T_float4:
 			// Don't include FLOAT4 due to known bugs that cause test failures.
 			// See #73743 and #48613.
@@ -57,7 +59,7 @@ func init() {
 	}
 
 	for _, typ := range types.OidToType {
-		if IsAllowedForArray(typ) {
+		if typ.Oid() != oid.T_xml && IsAllowedForArray(typ) {
 			arrayContentsTypes = append(arrayContentsTypes, typ)
 		}
 	}
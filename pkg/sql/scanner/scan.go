@@ -314,6 +314,10 @@ func (s *SQLScanner) Scan(lval ScanSymType) {
 				s.pos++
 				lval.SetID(lexbase.INET_CONTAINED_BY_OR_EQUALS)
 				return
+			case '%': // <<%
+				s.pos++
+				lval.SetID(lexbase.STRICT_WORD_SIMILAR)
+				return
 			}
 			lval.SetID(lexbase.LSHIFT)
 			return
@@ -335,6 +339,10 @@ func (s *SQLScanner) Scan(lval ScanSymType) {
 			s.pos++
 			lval.SetID(lexbase.CONTAINED_BY)
 			return
+		case '%': // <%
+			s.pos++
+			lval.SetID(lexbase.WORD_SIMILAR)
+			return
 		case '-': // <-
 			switch s.peekN(1) {
 			case '>': // <->
@@ -371,6 +379,21 @@ func (s *SQLScanner) Scan(lval ScanSymType) {
 		}
 		return
 
+	case '%':
+		switch s.peek() {
+		case '>': // %>
+			s.pos++
+			switch s.peek() {
+			case '>': // %>>
+				s.pos++
+				lval.SetID(lexbase.STRICT_WORD_SIMILAR_COMMUTED)
+				return
+			}
+			lval.SetID(lexbase.WORD_SIMILAR_COMMUTED)
+			return
+		}
+		return
+
 	case ':':
 		switch s.peek() {
 		case ':': // ::
@@ -44,6 +44,7 @@ const (
 	CategoryFuzzyStringMatching = "Fuzzy String Matching"
 	CategoryIDGeneration        = "ID generation"
 	CategoryJSON                = "JSONB"
+	CategoryLargeObject         = "Large object"
 	CategoryMultiRegion         = "Multi-region"
 	CategoryMultiTenancy        = "Multi-tenancy"
 	CategoryPGVector            = "PGVector"
@@ -54,6 +55,7 @@ const (
 	CategorySystemRepair        = "System repair"
 	CategoryClusterReplication  = "Cluster Replication and Migration"
 	CategoryTesting             = "Testing"
+	CategoryXML                 = "XML"
 )
 
 const (
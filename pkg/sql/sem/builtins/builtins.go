@@ -72,6 +72,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/syntheticprivilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/util/arith"
 	"github.com/cockroachdb/cockroach/pkg/util/bitarray"
 	"github.com/cockroachdb/cockroach/pkg/util/duration"
 	"github.com/cockroachdb/cockroach/pkg/util/encoding"
@@ -82,6 +83,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/humanizeutil"
 	"github.com/cockroachdb/cockroach/pkg/util/ipaddr"
 	"github.com/cockroachdb/cockroach/pkg/util/json"
+	"github.com/cockroachdb/cockroach/pkg/util/jsonpath"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/pretty"
 	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
@@ -2700,6 +2702,96 @@ months and years, use the timestamptz subtraction operator.`,
 		},
 	),
 
+	"date_bin": makeBuiltin(
+		tree.FunctionProperties{Category: builtinconstants.CategoryDateAndTime},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "stride", Typ: types.Interval},
+				{Name: "source", Typ: types.Timestamp},
+				{Name: "origin", Typ: types.Timestamp},
+			},
+			ReturnType: tree.FixedReturnType(types.Timestamp),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				res, err := dateBin(
+					tree.MustBeDInterval(args[0]).Duration,
+					args[1].(*tree.DTimestamp).Time,
+					args[2].(*tree.DTimestamp).Time,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.MakeDTimestamp(res, time.Microsecond)
+			},
+			Info: "Truncates `source` to the start of the bin of width `stride` that " +
+				"contains it, where the bins are aligned with `origin`. `stride` cannot " +
+				"contain months or years.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "stride", Typ: types.Interval},
+				{Name: "source", Typ: types.TimestampTZ},
+				{Name: "origin", Typ: types.TimestampTZ},
+			},
+			ReturnType: tree.FixedReturnType(types.TimestampTZ),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				res, err := dateBin(
+					tree.MustBeDInterval(args[0]).Duration,
+					args[1].(*tree.DTimestampTZ).Time,
+					args[2].(*tree.DTimestampTZ).Time,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return tree.MakeDTimestampTZ(res, time.Microsecond)
+			},
+			Info: "Truncates `source` to the start of the bin of width `stride` that " +
+				"contains it, where the bins are aligned with `origin`. `stride` cannot " +
+				"contain months or years.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"justify_days": makeBuiltin(
+		tree.FunctionProperties{Category: builtinconstants.CategoryDateAndTime},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "val", Typ: types.Interval}},
+			ReturnType: tree.FixedReturnType(types.Interval),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return &tree.DInterval{Duration: tree.MustBeDInterval(args[0]).JustifyDays()}, nil
+			},
+			Info:       "Adjusts `val` so that each 30-day period is represented as a month.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"justify_hours": makeBuiltin(
+		tree.FunctionProperties{Category: builtinconstants.CategoryDateAndTime},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "val", Typ: types.Interval}},
+			ReturnType: tree.FixedReturnType(types.Interval),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return &tree.DInterval{Duration: tree.MustBeDInterval(args[0]).JustifyHours()}, nil
+			},
+			Info:       "Adjusts `val` so that each 24-hour period is represented as a day.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
+	"justify_interval": makeBuiltin(
+		tree.FunctionProperties{Category: builtinconstants.CategoryDateAndTime},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "val", Typ: types.Interval}},
+			ReturnType: tree.FixedReturnType(types.Interval),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return &tree.DInterval{Duration: tree.MustBeDInterval(args[0]).JustifyInterval()}, nil
+			},
+			Info: "Adjusts `val` using both justify_days and justify_hours, with " +
+				"additional sign adjustments so that all parts have the same sign.",
+			Volatility: volatility.Immutable,
+		},
+	),
+
 	"current_date": makeBuiltin(
 		defProps(),
 		tree.Overload{
@@ -3945,21 +4037,13 @@ value if you rely on the HLC for accuracy.`,
 	"ts_debug":                       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"ts_headline":                    makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"ts_lexize":                      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"websearch_to_tsquery":           makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"array_to_tsvector":              makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"get_current_ts_config":          makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"numnode":                        makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"querytree":                      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"setweight":                      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"strip":                          makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"json_to_tsvector":               makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"jsonb_to_tsvector":              makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"ts_delete":                      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"ts_filter":                      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"ts_rank_cd":                     makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"ts_rewrite":                     makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"tsquery_phrase":                 makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
-	"tsvector_to_array":              makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"tsvector_update_trigger":        makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 	"tsvector_update_trigger_column": makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 7821, Category: builtinconstants.CategoryFullTextSearch}),
 
@@ -4069,13 +4153,123 @@ value if you rely on the HLC for accuracy.`,
 	// The behavior of both the JSON and JSONB data types in CockroachDB is
 	// similar to the behavior of the JSONB data type in Postgres.
 
-	"jsonb_path_exists":      makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_exists_opr":  makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_match":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_match_opr":   makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_query":       makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_query_array": makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
-	"jsonb_path_query_first": makeBuiltin(tree.FunctionProperties{UnsupportedWithIssue: 22513, Category: builtinconstants.CategoryJSON}),
+	"jsonb_path_exists": makeBuiltin(jsonProps(), jsonPathOverloads(types.Bool,
+		"Returns whether the SQL/JSON path returns any item for the specified JSON value.",
+		func(target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool) (tree.Datum, error) {
+			exists, ok, err := jsonpath.Exists(path, target, vars, silent)
+			if err != nil || !ok {
+				return tree.DNull, err
+			}
+			return tree.MakeDBool(tree.DBool(exists)), nil
+		})...),
+	"jsonb_path_exists_opr": makeBuiltin(jsonProps(), jsonPathOprOverload(types.Bool,
+		"Returns whether the SQL/JSON path returns any item for the specified JSON value, "+
+			"suppressing errors.",
+		func(target json.JSON, path *jsonpath.Path) (tree.Datum, error) {
+			exists, ok, err := jsonpath.Exists(path, target, nil /* vars */, true /* silent */)
+			if err != nil || !ok {
+				return tree.DNull, err
+			}
+			return tree.MakeDBool(tree.DBool(exists)), nil
+		})),
+	"jsonb_path_match": makeBuiltin(jsonProps(), jsonPathOverloads(types.Bool,
+		"Returns the result of the SQL/JSON path predicate check for the specified JSON value. "+
+			"Returns NULL if the result is unknown.",
+		func(target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool) (tree.Datum, error) {
+			match, ok, err := jsonpath.Match(path, target, vars, silent)
+			if err != nil || !ok {
+				return tree.DNull, err
+			}
+			return tree.MakeDBool(tree.DBool(match)), nil
+		})...),
+	"jsonb_path_match_opr": makeBuiltin(jsonProps(), jsonPathOprOverload(types.Bool,
+		"Returns the result of the SQL/JSON path predicate check for the specified JSON value, "+
+			"suppressing errors.",
+		func(target json.JSON, path *jsonpath.Path) (tree.Datum, error) {
+			match, ok, err := jsonpath.Match(path, target, nil /* vars */, true /* silent */)
+			if err != nil || !ok {
+				return tree.DNull, err
+			}
+			return tree.MakeDBool(tree.DBool(match)), nil
+		})),
+	"jsonb_path_query_array": makeBuiltin(jsonProps(), jsonPathOverloads(types.Jsonb,
+		"Returns all items returned by the SQL/JSON path for the specified JSON value, "+
+			"as a JSON array.",
+		func(target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool) (tree.Datum, error) {
+			items, err := jsonpath.Query(path, target, vars, silent)
+			if err != nil {
+				return nil, err
+			}
+			b := json.NewArrayBuilder(len(items))
+			for _, item := range items {
+				b.Add(item)
+			}
+			return tree.NewDJSON(b.Build()), nil
+		})...),
+	"jsonb_path_query_first": makeBuiltin(jsonProps(), jsonPathOverloads(types.Jsonb,
+		"Returns the first item returned by the SQL/JSON path for the specified JSON value, "+
+			"or NULL if there are no results.",
+		func(target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool) (tree.Datum, error) {
+			items, err := jsonpath.Query(path, target, vars, silent)
+			if err != nil || len(items) == 0 {
+				return tree.DNull, err
+			}
+			return tree.NewDJSON(items[0]), nil
+		})...),
+
+	// SQL/JSON query functions. These take the place of the JSON_EXISTS,
+	// JSON_VALUE and JSON_QUERY expressions of the SQL standard; the
+	// PASSING clause is the vars argument, and ERROR ON ERROR is the
+	// error_on_error argument.
+	"json_exists": makeBuiltin(jsonProps(), sqlJSONOverloads(types.Bool,
+		"Returns whether the SQL/JSON path returns any item for the specified JSON value. "+
+			"Returns false on error unless error_on_error is true.",
+		func(items []json.JSON) (tree.Datum, error) {
+			return tree.MakeDBool(len(items) > 0), nil
+		},
+	)...),
+	"json_value": makeBuiltin(jsonProps(), sqlJSONOverloads(types.String,
+		"Returns the scalar item returned by the SQL/JSON path for the specified JSON value, "+
+			"as text. Returns NULL if there is no item, and on error unless error_on_error is true.",
+		func(items []json.JSON) (tree.Datum, error) {
+			if len(items) == 0 {
+				return tree.DNull, nil
+			}
+			if len(items) > 1 {
+				return nil, pgerror.New(pgcode.MoreThanOneSQLJSONItem,
+					"JSON path expression in JSON_VALUE should return single scalar item")
+			}
+			switch items[0].Type() {
+			case json.NullJSONType:
+				return tree.DNull, nil
+			case json.ArrayJSONType, json.ObjectJSONType:
+				return nil, pgerror.New(pgcode.SQLJSONScalarRequired,
+					"JSON path expression in JSON_VALUE should return single scalar item")
+			}
+			text, err := items[0].AsText()
+			if err != nil {
+				return nil, err
+			}
+			return tree.NewDString(*text), nil
+		},
+	)...),
+	"json_query": makeBuiltin(jsonProps(), sqlJSONOverloads(types.Jsonb,
+		"Returns the item returned by the SQL/JSON path for the specified JSON value. "+
+			"Returns NULL if there is no item, and on error unless error_on_error is true.",
+		func(items []json.JSON) (tree.Datum, error) {
+			if len(items) == 0 {
+				return tree.DNull, nil
+			}
+			if len(items) > 1 {
+				return nil, errors.WithHint(
+					pgerror.New(pgcode.MoreThanOneSQLJSONItem,
+						"JSON path expression in JSON_QUERY should return single item without wrapper"),
+					"Use jsonb_path_query_array to wrap the items into an array.",
+				)
+			}
+			return tree.NewDJSON(items[0]), nil
+		},
+	)...),
 
 	"json_remove_path": makeBuiltin(jsonProps(),
 		tree.Overload{
@@ -4875,6 +5069,22 @@ value if you rely on the HLC for accuracy.`,
 		},
 	),
 
+	// Get the current session trace as JSON.
+	"crdb_internal.session_trace_json": makeBuiltin(
+		tree.FunctionProperties{Category: builtinconstants.CategorySystemInfo},
+		tree.Overload{
+			Types:      tree.ParamTypes{},
+			ReturnType: tree.FixedReturnType(types.Jsonb),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return evalCtx.Planner.SessionTraceJSON()
+			},
+			Info: "Returns the trace collected for the current session with SET tracing " +
+				"as a JSON array with one object per span. If tracing is still enabled, " +
+				"the trace collected so far is returned.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
 	// Toggles all spans of the requested trace to verbose or non-verbose.
 	"crdb_internal.set_trace_verbose": makeBuiltin(
 		tree.FunctionProperties{Category: builtinconstants.CategorySystemInfo},
@@ -9845,6 +10055,119 @@ func jsonProps() tree.FunctionProperties {
 	}
 }
 
+// jsonPathParams are the parameters of the jsonb_path_* builtins, of which
+// vars and silent are optional.
+var jsonPathParams = tree.ParamTypes{
+	{Name: "target", Typ: types.Jsonb},
+	{Name: "path", Typ: types.String},
+	{Name: "vars", Typ: types.Jsonb},
+	{Name: "silent", Typ: types.Bool},
+}
+
+// parseJSONPathArgs returns the arguments of a function taking a prefix of
+// jsonPathParams. vars is nil if it is not specified.
+func parseJSONPathArgs(
+	args tree.Datums,
+) (target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool, err error) {
+	target = tree.MustBeDJSON(args[0]).JSON
+	if path, err = jsonpath.Parse(string(tree.MustBeDString(args[1]))); err != nil {
+		return nil, nil, nil, false, err
+	}
+	if len(args) > 2 {
+		vars = tree.MustBeDJSON(args[2]).JSON
+	}
+	if len(args) > 3 {
+		silent = bool(tree.MustBeDBool(args[3]))
+	}
+	return target, path, vars, silent, nil
+}
+
+// jsonPathOverloads returns the overloads of a jsonb_path_* builtin, which
+// take a target JSON value, a SQL/JSON path and optionally the values of the
+// variables used in the path and whether to suppress errors.
+func jsonPathOverloads(
+	returnType *types.T,
+	info string,
+	fn func(target json.JSON, path *jsonpath.Path, vars json.JSON, silent bool) (tree.Datum, error),
+) []tree.Overload {
+	overloads := make([]tree.Overload, 0, 3)
+	for n := 2; n <= len(jsonPathParams); n++ {
+		overloads = append(overloads, tree.Overload{
+			Types:      jsonPathParams[:n],
+			ReturnType: tree.FixedReturnType(returnType),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				target, path, vars, silent, err := parseJSONPathArgs(args)
+				if err != nil {
+					return nil, err
+				}
+				return fn(target, path, vars, silent)
+			},
+			Info:       info,
+			Volatility: volatility.Immutable,
+		})
+	}
+	return overloads
+}
+
+// jsonPathOprOverload returns the overload of a jsonb_path_*_opr builtin,
+// which are the functions underlying the @? and @@ operators in Postgres.
+func jsonPathOprOverload(
+	returnType *types.T, info string, fn func(target json.JSON, path *jsonpath.Path) (tree.Datum, error),
+) tree.Overload {
+	return tree.Overload{
+		Types:      jsonPathParams[:2],
+		ReturnType: tree.FixedReturnType(returnType),
+		Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+			target, path, _, _, err := parseJSONPathArgs(args)
+			if err != nil {
+				return nil, err
+			}
+			return fn(target, path)
+		},
+		Info:       info,
+		Volatility: volatility.Immutable,
+	}
+}
+
+// sqlJSONOverloads returns the overloads of a SQL/JSON query function, which
+// take a target JSON value, a SQL/JSON path and optionally the values of the
+// variables used in the path and whether errors are returned. fn computes the
+// result from the items returned by the path; if errors are not returned, the
+// result is NULL when it fails.
+func sqlJSONOverloads(
+	returnType *types.T, info string, fn func(items []json.JSON) (tree.Datum, error),
+) []tree.Overload {
+	params := append(tree.ParamTypes(nil), jsonPathParams[:3]...)
+	params = append(params, tree.ParamType{Name: "error_on_error", Typ: types.Bool})
+	overloads := make([]tree.Overload, 0, 3)
+	for n := 2; n <= len(params); n++ {
+		overloads = append(overloads, tree.Overload{
+			Types:      params[:n],
+			ReturnType: tree.FixedReturnType(returnType),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				target, path, vars, errorOnError, err := parseJSONPathArgs(args)
+				if err != nil {
+					return nil, err
+				}
+				// Unless error_on_error is true, errors in the evaluation of the
+				// path produce no items, which have the same result as an error.
+				items, err := jsonpath.Query(path, target, vars, !errorOnError /* silent */)
+				if err != nil {
+					return nil, err
+				}
+				res, err := fn(items)
+				if err != nil && !errorOnError {
+					return tree.DNull, nil
+				}
+				return res, err
+			},
+			Info:       info,
+			Volatility: volatility.Immutable,
+		})
+	}
+	return overloads
+}
+
 var jsonBuildObjectImpl = tree.Overload{
 	Types:      tree.VariadicType{VarType: types.Any},
 	ReturnType: tree.FixedReturnType(types.Jsonb),
@@ -11374,6 +11697,43 @@ func truncateTimestamp(fromTime time.Time, timeSpan string) (*tree.DTimestampTZ,
 	return tree.MakeDTimestampTZ(toTime, time.Microsecond)
 }
 
+// dateBin returns the start of the bin of width stride, aligned with origin,
+// that contains source. Bins are computed on absolute time, so the result does
+// not depend on the time zone. It follows Postgres' timestamp_bin.
+func dateBin(stride duration.Duration, source, origin time.Time) (time.Time, error) {
+	if source == pgdate.TimeInfinity || source == pgdate.TimeNegativeInfinity {
+		return source, nil
+	}
+	if origin == pgdate.TimeInfinity || origin == pgdate.TimeNegativeInfinity {
+		return time.Time{}, pgerror.New(pgcode.DatetimeFieldOverflow, "origin out of range")
+	}
+	if stride.Months != 0 {
+		return time.Time{}, pgerror.New(pgcode.FeatureNotSupported,
+			"timestamps cannot be binned into intervals containing months or years")
+	}
+	const microsPerDay = duration.SecsPerDay * duration.MicrosPerSec
+	errIntervalOutOfRange := pgerror.New(pgcode.DatetimeFieldOverflow, "interval out of range")
+	if stride.Days > math.MaxInt64/microsPerDay || stride.Days < math.MinInt64/microsPerDay {
+		return time.Time{}, errIntervalOutOfRange
+	}
+	strideMicros, ok := arith.AddWithOverflow(stride.Days*microsPerDay, stride.Nanos()/int64(time.Microsecond))
+	if !ok {
+		return time.Time{}, errIntervalOutOfRange
+	}
+	if strideMicros <= 0 {
+		return time.Time{}, pgerror.New(pgcode.DatetimeFieldOverflow, "stride must be greater than zero")
+	}
+	diff := duration.DiffMicros(source, origin)
+	modulo := diff % strideMicros
+	delta := diff - modulo
+	if modulo < 0 {
+		// Round towards negative infinity, so that sources before the origin
+		// fall into the bin that starts before them.
+		delta -= strideMicros
+	}
+	return duration.AddMicros(origin, delta), nil
+}
+
 func truncateInterval(fromInterval *tree.DInterval, timeSpan string) (*tree.DInterval, error) {
 
 	toInterval := tree.DInterval{}
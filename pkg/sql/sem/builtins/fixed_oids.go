@@ -2607,6 +2607,89 @@ var builtinOidsArray = []string{
 	2644: `crdb_internal.range_stats_with_errors(key: bytes) -> jsonb`,
 	2645: `crdb_internal.lease_holder_with_errors(key: bytes) -> jsonb`,
 	2646: `crdb_internal.pretty_key(raw_key: bytes) -> string`,
+	2647: `pg_notify(channel: string, payload: string) -> void`,
+	2648: `lo_create(lobj: oid) -> oid`,
+	2649: `lo_creat(mode: int4) -> oid`,
+	2650: `lo_open(lobj: oid, mode: int4) -> int4`,
+	2651: `lo_close(fd: int4) -> int4`,
+	2652: `loread(fd: int4, len: int4) -> bytes`,
+	2653: `lowrite(fd: int4, data: bytes) -> int4`,
+	2654: `lo_lseek(fd: int4, offset: int4, whence: int4) -> int4`,
+	2655: `lo_lseek64(fd: int4, offset: int, whence: int4) -> int`,
+	2656: `lo_tell(fd: int4) -> int4`,
+	2657: `lo_tell64(fd: int4) -> int`,
+	2658: `lo_truncate(fd: int4, len: int4) -> int4`,
+	2659: `lo_unlink(lobj: oid) -> int4`,
+	2660: `lo_get(lobj: oid) -> bytes`,
+	2661: `lo_get(lobj: oid, offset: int, len: int4) -> bytes`,
+	2662: `lo_put(lobj: oid, offset: int, data: bytes) -> void`,
+	2663: `lo_from_bytea(lobj: oid, data: bytes) -> oid`,
+	2664: `websearch_to_tsquery(config: string, text: string) -> tsquery`,
+	2665: `websearch_to_tsquery(text: string) -> tsquery`,
+	2666: `setweight(vector: tsvector, weight: "char") -> tsvector`,
+	2667: `setweight(vector: tsvector, weight: "char", lexemes: string[]) -> tsvector`,
+	2668: `strip(vector: tsvector) -> tsvector`,
+	2669: `ts_delete(vector: tsvector, lexeme: string) -> tsvector`,
+	2670: `ts_delete(vector: tsvector, lexemes: string[]) -> tsvector`,
+	2671: `ts_filter(vector: tsvector, weights: "char"[]) -> tsvector`,
+	2672: `tsvector_to_array(vector: tsvector) -> string[]`,
+	2673: `array_to_tsvector(lexemes: string[]) -> tsvector`,
+	2674: `numnode(query: tsquery) -> int`,
+	2675: `word_similarity(left: string, right: string) -> float`,
+	2676: `strict_word_similarity(left: string, right: string) -> float`,
+	2677: `show_limit() -> float4`,
+	2678: `set_limit(threshold: float4) -> float4`,
+	2679: `l2_normalize(vector: vector) -> vector`,
+	2680: `subvector(vector: vector, start: int, count: int) -> vector`,
+	2681: `xpath(xpath: string, xml: xml) -> xml[]`,
+	2682: `xpath_exists(xpath: string, xml: xml) -> bool`,
+	2683: `xml_is_well_formed(xml: string) -> bool`,
+	2684: `xml_is_well_formed_document(xml: string) -> bool`,
+	2685: `xml_is_well_formed_content(xml: string) -> bool`,
+	2686: `xpath(xpath: string, xml: xml, nsarray: string[]) -> xml[]`,
+	2687: `xpath_exists(xpath: string, xml: xml, nsarray: string[]) -> bool`,
+	2688: `xmltable(row_path: string, xml: xml) -> tuple`,
+	2689: `xmltable(row_path: string, xml: xml, column_paths: string[]) -> tuple`,
+	2690: `xml_send(xml: xml) -> bytes`,
+	2691: `xml_recv(input: anyelement) -> xml`,
+	2692: `xml_out(xml: xml) -> bytes`,
+	2693: `xml_in(input: anyelement) -> xml`,
+	2694: `date_bin(stride: interval, source: timestamp, origin: timestamp) -> timestamp`,
+	2695: `date_bin(stride: interval, source: timestamptz, origin: timestamptz) -> timestamptz`,
+	2696: `justify_days(val: interval) -> interval`,
+	2697: `justify_hours(val: interval) -> interval`,
+	2698: `justify_interval(val: interval) -> interval`,
+	2699: `generate_series(start: timestamptz, end: timestamptz, step: interval, timezone: string) -> timestamptz`,
+	2700: `jsonb_path_exists(target: jsonb, path: string) -> bool`,
+	2701: `jsonb_path_exists(target: jsonb, path: string, vars: jsonb) -> bool`,
+	2702: `jsonb_path_exists(target: jsonb, path: string, vars: jsonb, silent: bool) -> bool`,
+	2703: `jsonb_path_exists_opr(target: jsonb, path: string) -> bool`,
+	2704: `jsonb_path_match(target: jsonb, path: string) -> bool`,
+	2705: `jsonb_path_match(target: jsonb, path: string, vars: jsonb) -> bool`,
+	2706: `jsonb_path_match(target: jsonb, path: string, vars: jsonb, silent: bool) -> bool`,
+	2707: `jsonb_path_match_opr(target: jsonb, path: string) -> bool`,
+	2708: `jsonb_path_query_array(target: jsonb, path: string) -> jsonb`,
+	2709: `jsonb_path_query_array(target: jsonb, path: string, vars: jsonb) -> jsonb`,
+	2710: `jsonb_path_query_array(target: jsonb, path: string, vars: jsonb, silent: bool) -> jsonb`,
+	2711: `jsonb_path_query_first(target: jsonb, path: string) -> jsonb`,
+	2712: `jsonb_path_query_first(target: jsonb, path: string, vars: jsonb) -> jsonb`,
+	2713: `jsonb_path_query_first(target: jsonb, path: string, vars: jsonb, silent: bool) -> jsonb`,
+	2714: `json_exists(target: jsonb, path: string) -> bool`,
+	2715: `json_exists(target: jsonb, path: string, vars: jsonb) -> bool`,
+	2716: `json_exists(target: jsonb, path: string, vars: jsonb, error_on_error: bool) -> bool`,
+	2717: `json_value(target: jsonb, path: string) -> string`,
+	2718: `json_value(target: jsonb, path: string, vars: jsonb) -> string`,
+	2719: `json_value(target: jsonb, path: string, vars: jsonb, error_on_error: bool) -> string`,
+	2720: `json_query(target: jsonb, path: string) -> jsonb`,
+	2721: `json_query(target: jsonb, path: string, vars: jsonb) -> jsonb`,
+	2722: `json_query(target: jsonb, path: string, vars: jsonb, error_on_error: bool) -> jsonb`,
+	2723: `jsonb_path_query(target: jsonb, path: string) -> jsonb`,
+	2724: `jsonb_path_query(target: jsonb, path: string, vars: jsonb) -> jsonb`,
+	2725: `jsonb_path_query(target: jsonb, path: string, vars: jsonb, silent: bool) -> jsonb`,
+	2726: `json_table(target: jsonb, path: string) -> tuple`,
+	2727: `json_table(target: jsonb, path: string, column_paths: string[]) -> tuple`,
+	2728: `json_table(target: jsonb, path: string, column_paths: string[], vars: jsonb) -> tuple`,
+	2729: `crdb_internal.session_trace_json() -> jsonb`,
 }
 
 var builtinOidsBySignature map[string]oid.Oid
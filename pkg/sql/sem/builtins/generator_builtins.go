@@ -41,6 +41,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/duration"
 	"github.com/cockroachdb/cockroach/pkg/util/envutil"
 	"github.com/cockroachdb/cockroach/pkg/util/json"
+	"github.com/cockroachdb/cockroach/pkg/util/jsonpath"
 	"github.com/cockroachdb/cockroach/pkg/util/mon"
 	"github.com/cockroachdb/cockroach/pkg/util/randident"
 	"github.com/cockroachdb/cockroach/pkg/util/randident/randidentcfg"
@@ -49,8 +50,10 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 	"github.com/cockroachdb/cockroach/pkg/util/tracing"
 	"github.com/cockroachdb/cockroach/pkg/util/tracing/tracingpb"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
+	"github.com/lib/pq/oid"
 )
 
 // See the comments at the start of generators.go for details about
@@ -215,7 +218,21 @@ var generators = map[string]builtinDefinition{
 			tree.ParamTypes{{Name: "start", Typ: types.TimestampTZ}, {Name: "end", Typ: types.TimestampTZ}, {Name: "step", Typ: types.Interval}},
 			seriesTSTZValueGeneratorType,
 			makeTSTZSeriesGenerator,
-			"Produces a virtual table containing the timestampTZ values from `start` to `end`, inclusive, by increment of `step`.",
+			"Produces a virtual table containing the timestampTZ values from `start` to `end`, inclusive, by increment of `step`. "+
+				"Days and months in `step` are added in the session time zone.",
+			volatility.Stable,
+		),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "start", Typ: types.TimestampTZ},
+				{Name: "end", Typ: types.TimestampTZ},
+				{Name: "step", Typ: types.Interval},
+				{Name: "timezone", Typ: types.String},
+			},
+			seriesTSTZValueGeneratorType,
+			makeTSTZSeriesGenerator,
+			"Produces a virtual table containing the timestampTZ values from `start` to `end`, inclusive, by increment of `step`. "+
+				"Days and months in `step` are added in the given time zone.",
 			volatility.Immutable,
 		),
 	),
@@ -430,6 +447,84 @@ var generators = map[string]builtinDefinition{
 	"json_to_recordset":  makeBuiltin(recordGenProps(), jsonToRecordSetImpl),
 	"jsonb_to_recordset": makeBuiltin(recordGenProps(), jsonToRecordSetImpl),
 
+	"xmltable": makeBuiltin(recordGenProps(),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "row_path", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+			},
+			types.EmptyTuple,
+			makeXMLTableGenerator,
+			"Returns a row for each node selected by the XPath 1.0 row_path in the "+
+				"XML document. The columns are defined by the column definition list, "+
+				"and the value of each column is the child element of the row's node "+
+				"with the same name.",
+			volatility.Immutable,
+		),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "row_path", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+				{Name: "column_paths", Typ: types.StringArray},
+			},
+			types.EmptyTuple,
+			makeXMLTableGenerator,
+			"Returns a row for each node selected by the XPath 1.0 row_path in the "+
+				"XML document. The columns are defined by the column definition list, "+
+				"and the value of each column is the result of the corresponding path "+
+				"in column_paths, evaluated against the row's node.",
+			volatility.Immutable,
+		),
+	),
+
+	"jsonb_path_query": makeBuiltin(genProps(), jsonPathQueryImpls()...),
+	"json_table": makeBuiltin(recordGenProps(),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "target", Typ: types.Jsonb},
+				{Name: "path", Typ: types.String},
+			},
+			// NOTE: this type will never actually get used. It is replaced in the
+			// optimizer by looking at the most recent AS alias clause.
+			types.EmptyTuple,
+			makeJSONTableGenerator,
+			"Returns a row for each item returned by the SQL/JSON path for the specified "+
+				"JSON value. The columns are defined by the column definition list, and "+
+				"the value of each column is the item at the key of the same name.",
+			volatility.Immutable,
+		),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "target", Typ: types.Jsonb},
+				{Name: "path", Typ: types.String},
+				{Name: "column_paths", Typ: types.StringArray},
+			},
+			types.EmptyTuple,
+			makeJSONTableGenerator,
+			"Returns a row for each item returned by the SQL/JSON path for the specified "+
+				"JSON value. The columns are defined by the column definition list, and "+
+				"the value of each column is the item returned by the corresponding path "+
+				"in column_paths, evaluated against the row's item.",
+			volatility.Immutable,
+		),
+		makeGeneratorOverload(
+			tree.ParamTypes{
+				{Name: "target", Typ: types.Jsonb},
+				{Name: "path", Typ: types.String},
+				{Name: "column_paths", Typ: types.StringArray},
+				{Name: "vars", Typ: types.Jsonb},
+			},
+			types.EmptyTuple,
+			makeJSONTableGenerator,
+			"Returns a row for each item returned by the SQL/JSON path for the specified "+
+				"JSON value. The columns are defined by the column definition list, and "+
+				"the value of each column is the item returned by the corresponding path "+
+				"in column_paths, evaluated against the row's item. vars contains the "+
+				"values of the variables used in the paths.",
+			volatility.Immutable,
+		),
+	),
+
 	"crdb_internal.check_consistency": makeBuiltin(
 		tree.FunctionProperties{
 			Category:         builtinconstants.CategorySystemInfo,
@@ -1090,7 +1185,7 @@ func makeTSSeriesGenerator(
 }
 
 func makeTSTZSeriesGenerator(
-	_ context.Context, _ *eval.Context, args tree.Datums,
+	_ context.Context, evalCtx *eval.Context, args tree.Datums,
 ) (eval.ValueGenerator, error) {
 	start := args[0].(*tree.DTimestampTZ).Time
 	stop := args[1].(*tree.DTimestampTZ).Time
@@ -1100,6 +1195,21 @@ func makeTSTZSeriesGenerator(
 		return nil, errStepCannotBeZero
 	}
 
+	// As with timestamptz + interval, the calendar math is performed in the
+	// session time zone, or in the given one, so that adding a day crosses
+	// daylight saving transitions correctly.
+	loc := evalCtx.GetLocation()
+	if len(args) > 3 {
+		var err error
+		loc, err = timeutil.TimeZoneStringToLocation(
+			string(tree.MustBeDString(args[3])), timeutil.TimeZoneStringToLocationPOSIXStandard,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	start = start.In(loc)
+
 	return &seriesValueGenerator{
 		origStart: start,
 		stop:      stop,
@@ -2091,6 +2201,322 @@ func (j *jsonRecordSetGenerator) Next(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// xmlTableGenerator supports the execution of xmltable, which takes the place
+// of the XMLTABLE expression of the SQL standard. It returns a row for each
+// node selected by the row path. A column is NULL if its path selects no
+// nodes. XML columns contain the serialized nodes selected by their path, and
+// other columns contain the string-value of the selected node converted to
+// the column's type.
+type xmlTableGenerator struct {
+	evalCtx     *eval.Context
+	doc         *xmlutil.Node
+	rowPath     string
+	columnPaths []string
+
+	types     []*types.T
+	rows      []*xmlutil.Node
+	nextIndex int
+	values    tree.Datums
+}
+
+var _ eval.AliasAwareValueGenerator = &xmlTableGenerator{}
+
+func makeXMLTableGenerator(
+	_ context.Context, evalCtx *eval.Context, args tree.Datums,
+) (eval.ValueGenerator, error) {
+	doc, err := xmlutil.ParseDocument(string(tree.MustBeDString(args[1])))
+	if err != nil {
+		return nil, err
+	}
+	g := &xmlTableGenerator{
+		evalCtx: evalCtx,
+		doc:     doc,
+		rowPath: string(tree.MustBeDString(args[0])),
+	}
+	if len(args) > 2 {
+		arr := tree.MustBeDArray(args[2])
+		if err := checkHasNulls(*arr); err != nil {
+			return nil, err
+		}
+		g.columnPaths = make([]string, arr.Len())
+		for i, d := range arr.Array {
+			g.columnPaths[i] = string(tree.MustBeDString(d))
+		}
+	}
+	return g, nil
+}
+
+// SetAlias implements the eval.AliasAwareValueGenerator interface.
+func (g *xmlTableGenerator) SetAlias(types []*types.T, labels []string) error {
+	if len(types) != len(labels) {
+		return errors.AssertionFailedf("unexpected mismatched types/labels list in xmltable %v %v", types, labels)
+	}
+	g.types = types
+	if g.columnPaths == nil {
+		// Without column paths, each column is the child element of the same
+		// name, as with the default PATH of an XMLTABLE column.
+		g.columnPaths = labels
+	}
+	if len(g.columnPaths) != len(types) {
+		return pgerror.Newf(pgcode.DatatypeMismatch,
+			"xmltable has %d column paths but the column definition list has %d columns",
+			len(g.columnPaths), len(types))
+	}
+	return nil
+}
+
+// ResolvedType implements the eval.ValueGenerator interface.
+func (g *xmlTableGenerator) ResolvedType() *types.T { return types.AnyTuple }
+
+// Start implements the eval.ValueGenerator interface.
+func (g *xmlTableGenerator) Start(_ context.Context, _ *kv.Txn) error {
+	res, err := xmlutil.Eval(g.rowPath, g.doc, nil /* namespaces */)
+	if err != nil {
+		return err
+	}
+	if !res.IsNodeSet {
+		return pgerror.New(pgcode.DataException, "XPath row expression must return a node-set")
+	}
+	g.rows = res.Nodes
+	g.nextIndex = -1
+	g.values = make(tree.Datums, len(g.types))
+	return nil
+}
+
+// Close implements the eval.ValueGenerator interface.
+func (g *xmlTableGenerator) Close(_ context.Context) {}
+
+// Next implements the eval.ValueGenerator interface.
+func (g *xmlTableGenerator) Next(ctx context.Context) (bool, error) {
+	g.nextIndex++
+	if g.nextIndex >= len(g.rows) {
+		return false, nil
+	}
+	row := g.rows[g.nextIndex]
+	for i, p := range g.columnPaths {
+		res, err := xmlutil.Eval(p, row, nil /* namespaces */)
+		if err != nil {
+			return false, err
+		}
+		g.values[i] = tree.DNull
+		if res.IsNodeSet && len(res.Nodes) == 0 {
+			continue
+		}
+		if g.types[i].Oid() == oid.T_xml {
+			var buf strings.Builder
+			if res.IsNodeSet {
+				for _, n := range res.Nodes {
+					buf.WriteString(n.String())
+				}
+			} else {
+				buf.WriteString((&xmlutil.Node{Kind: xmlutil.TextNode, Value: res.Value}).String())
+			}
+			g.values[i] = tree.NewDString(buf.String())
+			continue
+		}
+		val := res.Value
+		if res.IsNodeSet {
+			if len(res.Nodes) > 1 {
+				return false, pgerror.Newf(pgcode.CardinalityViolation,
+					"more than one value returned by column XPath expression %q", p)
+			}
+			val = res.Nodes[0].StringValue()
+		}
+		if g.values[i], err = eval.PerformCast(ctx, g.evalCtx, tree.NewDString(val), g.types[i]); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Values implements the eval.ValueGenerator interface.
+func (g *xmlTableGenerator) Values() (tree.Datums, error) {
+	return g.values, nil
+}
+
+func jsonPathQueryImpls() []tree.Overload {
+	overloads := make([]tree.Overload, 0, 3)
+	for n := 2; n <= len(jsonPathParams); n++ {
+		overloads = append(overloads, makeGeneratorOverload(
+			jsonPathParams[:n],
+			types.Jsonb,
+			makeJSONPathQueryGenerator,
+			"Returns the items returned by the SQL/JSON path for the specified JSON value.",
+			volatility.Immutable,
+		))
+	}
+	return overloads
+}
+
+// jsonPathQueryGenerator supports the execution of jsonb_path_query.
+type jsonPathQueryGenerator struct {
+	items     []json.JSON
+	nextIndex int
+}
+
+var _ eval.ValueGenerator = &jsonPathQueryGenerator{}
+
+func makeJSONPathQueryGenerator(
+	_ context.Context, _ *eval.Context, args tree.Datums,
+) (eval.ValueGenerator, error) {
+	target, path, vars, silent, err := parseJSONPathArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	items, err := jsonpath.Query(path, target, vars, silent)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonPathQueryGenerator{items: items}, nil
+}
+
+// ResolvedType implements the eval.ValueGenerator interface.
+func (g *jsonPathQueryGenerator) ResolvedType() *types.T { return types.Jsonb }
+
+// Start implements the eval.ValueGenerator interface.
+func (g *jsonPathQueryGenerator) Start(_ context.Context, _ *kv.Txn) error {
+	g.nextIndex = -1
+	return nil
+}
+
+// Close implements the eval.ValueGenerator interface.
+func (g *jsonPathQueryGenerator) Close(_ context.Context) {}
+
+// Next implements the eval.ValueGenerator interface.
+func (g *jsonPathQueryGenerator) Next(_ context.Context) (bool, error) {
+	g.nextIndex++
+	return g.nextIndex < len(g.items), nil
+}
+
+// Values implements the eval.ValueGenerator interface.
+func (g *jsonPathQueryGenerator) Values() (tree.Datums, error) {
+	return tree.Datums{tree.NewDJSON(g.items[g.nextIndex])}, nil
+}
+
+// jsonTableGenerator supports the execution of json_table, which takes the
+// place of the JSON_TABLE expression of the SQL standard. It returns a row
+// for each item returned by the row path. Errors in the evaluation of the
+// row path produce no rows, and errors in the evaluation or conversion of a
+// column make it NULL, as with the default ON ERROR behavior of JSON_TABLE.
+type jsonTableGenerator struct {
+	evalCtx     *eval.Context
+	target      json.JSON
+	path        *jsonpath.Path
+	columnPaths []*jsonpath.Path
+	vars        json.JSON
+
+	types     []*types.T
+	items     []json.JSON
+	nextIndex int
+	values    tree.Datums
+}
+
+var _ eval.AliasAwareValueGenerator = &jsonTableGenerator{}
+
+func makeJSONTableGenerator(
+	_ context.Context, evalCtx *eval.Context, args tree.Datums,
+) (eval.ValueGenerator, error) {
+	target, path, _, _, err := parseJSONPathArgs(args[:2])
+	if err != nil {
+		return nil, err
+	}
+	g := &jsonTableGenerator{evalCtx: evalCtx, target: target, path: path}
+	if len(args) > 2 {
+		arr := tree.MustBeDArray(args[2])
+		if err := checkHasNulls(*arr); err != nil {
+			return nil, err
+		}
+		g.columnPaths = make([]*jsonpath.Path, arr.Len())
+		for i, d := range arr.Array {
+			if g.columnPaths[i], err = jsonpath.Parse(string(tree.MustBeDString(d))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(args) > 3 {
+		g.vars = tree.MustBeDJSON(args[3]).JSON
+	}
+	return g, nil
+}
+
+// jsonPathKeyEscaper escapes a key to be used in a quoted SQL/JSON path
+// member accessor.
+var jsonPathKeyEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// SetAlias implements the eval.AliasAwareValueGenerator interface.
+func (g *jsonTableGenerator) SetAlias(types []*types.T, labels []string) error {
+	if len(types) != len(labels) {
+		return errors.AssertionFailedf("unexpected mismatched types/labels list in json_table %v %v", types, labels)
+	}
+	g.types = types
+	if g.columnPaths == nil {
+		// Without column paths, each column is the item at the key of the same
+		// name, as with the default PATH of a JSON_TABLE column.
+		g.columnPaths = make([]*jsonpath.Path, len(labels))
+		for i, label := range labels {
+			p, err := jsonpath.Parse(`$."` + jsonPathKeyEscaper.Replace(label) + `"`)
+			if err != nil {
+				return err
+			}
+			g.columnPaths[i] = p
+		}
+	}
+	if len(g.columnPaths) != len(types) {
+		return pgerror.Newf(pgcode.DatatypeMismatch,
+			"json_table has %d column paths but the column definition list has %d columns",
+			len(g.columnPaths), len(types))
+	}
+	return nil
+}
+
+// ResolvedType implements the eval.ValueGenerator interface.
+func (g *jsonTableGenerator) ResolvedType() *types.T { return types.AnyTuple }
+
+// Start implements the eval.ValueGenerator interface.
+func (g *jsonTableGenerator) Start(_ context.Context, _ *kv.Txn) error {
+	items, err := jsonpath.Query(g.path, g.target, g.vars, true /* silent */)
+	if err != nil {
+		return err
+	}
+	g.items = items
+	g.nextIndex = -1
+	g.values = make(tree.Datums, len(g.types))
+	return nil
+}
+
+// Close implements the eval.ValueGenerator interface.
+func (g *jsonTableGenerator) Close(_ context.Context) {}
+
+// Next implements the eval.ValueGenerator interface.
+func (g *jsonTableGenerator) Next(ctx context.Context) (bool, error) {
+	g.nextIndex++
+	if g.nextIndex >= len(g.items) {
+		return false, nil
+	}
+	item := g.items[g.nextIndex]
+	for i, p := range g.columnPaths {
+		g.values[i] = tree.DNull
+		res, err := jsonpath.Query(p, item, g.vars, true /* silent */)
+		if err != nil {
+			return false, err
+		}
+		if len(res) != 1 {
+			continue
+		}
+		if g.types[i].Family() == types.JsonFamily {
+			g.values[i] = tree.NewDJSON(res[0])
+		} else if d, err := eval.PopulateDatumWithJSON(ctx, g.evalCtx, res[0], g.types[i]); err == nil {
+			g.values[i] = d
+		}
+	}
+	return true, nil
+}
+
+// Values implements the eval.ValueGenerator interface.
+func (g *jsonTableGenerator) Values() (tree.Datums, error) {
+	return g.values, nil
+}
+
 type checkConsistencyGenerator struct {
 	txn                *kv.Txn // to load range descriptors
 	consistencyChecker eval.ConsistencyCheckRunner
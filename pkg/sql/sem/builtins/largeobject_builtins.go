@@ -0,0 +1,726 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package builtins
+
+import (
+	"context"
+	"math"
+	"math/rand"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
+)
+
+func init() {
+	for k, v := range largeObjectBuiltins {
+		v.props.Category = builtinconstants.CategoryLargeObject
+		v.props.DistsqlBlocklist = true
+		const enforceClass = true
+		registerBuiltin(k, v, tree.NormalClass, enforceClass)
+	}
+}
+
+// The large-object builtins emulate the server-side large-object functions of
+// Postgres (https://www.postgresql.org/docs/current/lo-funcs.html). The
+// contents of large objects are stored in system.large_objects, and their
+// owners in system.large_object_metadata. As in Postgres with
+// lo_compat_privileges disabled, a large object can only be read, written or
+// unlinked by its owner (or a member of the owning role) and by admins.
+// GRANT ON LARGE OBJECT is not supported.
+var largeObjectBuiltins = map[string]builtinDefinition{
+	"lo_create": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lobj", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				loid, err := loCreate(ctx, evalCtx, tree.MustBeDOid(args[0]).Oid)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDOid(loid), nil
+			},
+			Info: "Creates an empty large object with the given OID and returns its OID. " +
+				"If the OID is 0, an unused OID is assigned.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_creat": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "mode", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				loid, err := loCreate(ctx, evalCtx, 0 /* loid */)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDOid(loid), nil
+			},
+			Info:       "Creates an empty large object with an unused OID and returns its OID. The mode is ignored.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_open": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lobj", Typ: types.Oid}, {Name: "mode", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				ds, err := largeObjectDescriptors(ctx, evalCtx)
+				if err != nil {
+					return nil, err
+				}
+				loid := tree.MustBeDOid(args[0]).Oid
+				mode := int32(tree.MustBeDInt(args[1]))
+				if mode&(largeobject.ModeRead|largeobject.ModeWrite) == 0 {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+						"invalid flags for opening a large object: %d", mode)
+				}
+				if err := loCheckPrivilege(ctx, evalCtx, loid, false /* unlink */); err != nil {
+					return nil, err
+				}
+				return tree.NewDInt(tree.DInt(ds.Open(loid, mode))), nil
+			},
+			Info: "Opens the given large object and returns a descriptor for it. The mode " +
+				"is a combination of INV_READ (x'40000') and INV_WRITE (x'20000'). The " +
+				"descriptor is closed at the end of the transaction.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_close": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				ds, err := largeObjectDescriptors(ctx, evalCtx)
+				if err != nil {
+					return nil, err
+				}
+				if err := ds.Close(int32(tree.MustBeDInt(args[0]))); err != nil {
+					return nil, err
+				}
+				return tree.DZero, nil
+			},
+			Info:       "Closes the given large-object descriptor.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"loread": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}, {Name: "len", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				d, err := largeObjectDescriptor(ctx, evalCtx, args[0])
+				if err != nil {
+					return nil, err
+				}
+				if !d.CanRead() {
+					return nil, pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+						"large object descriptor %d was not opened for reading", tree.MustBeDInt(args[0]))
+				}
+				length := int64(tree.MustBeDInt(args[1]))
+				if length < 0 {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+						"requested length cannot be negative")
+				}
+				data, err := loRead(ctx, evalCtx, d.OID, d.Offset, length)
+				if err != nil {
+					return nil, err
+				}
+				d.Offset += int64(len(data))
+				return tree.NewDBytes(tree.DBytes(data)), nil
+			},
+			Info: "Reads up to `len` bytes from the current position of the given " +
+				"large-object descriptor.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lowrite": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}, {Name: "data", Typ: types.Bytes}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				d, err := largeObjectDescriptor(ctx, evalCtx, args[0])
+				if err != nil {
+					return nil, err
+				}
+				if !d.CanWrite() {
+					return nil, pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+						"large object descriptor %d was not opened for writing", tree.MustBeDInt(args[0]))
+				}
+				data := []byte(tree.MustBeDBytes(args[1]))
+				if err := loWrite(ctx, evalCtx, d.OID, d.Offset, data); err != nil {
+					return nil, err
+				}
+				d.Offset += int64(len(data))
+				return tree.NewDInt(tree.DInt(len(data))), nil
+			},
+			Info: "Writes the given data at the current position of the given " +
+				"large-object descriptor and returns the number of bytes written.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_lseek": makeBuiltin(
+		tree.FunctionProperties{},
+		loSeekOverload(types.Int4, types.Int4),
+	),
+
+	"lo_lseek64": makeBuiltin(
+		tree.FunctionProperties{},
+		loSeekOverload(types.Int, types.Int),
+	),
+
+	"lo_tell": makeBuiltin(
+		tree.FunctionProperties{},
+		loTellOverload(types.Int4),
+	),
+
+	"lo_tell64": makeBuiltin(
+		tree.FunctionProperties{},
+		loTellOverload(types.Int),
+	),
+
+	"lo_truncate": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}, {Name: "len", Typ: types.Int4}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				d, err := largeObjectDescriptor(ctx, evalCtx, args[0])
+				if err != nil {
+					return nil, err
+				}
+				if !d.CanWrite() {
+					return nil, pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+						"large object descriptor %d was not opened for writing", tree.MustBeDInt(args[0]))
+				}
+				length := int64(tree.MustBeDInt(args[1]))
+				if length < 0 || length > largeobject.MaxSize {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+						"invalid large object truncation target: %d", length)
+				}
+				if err := loTruncate(ctx, evalCtx, d.OID, length); err != nil {
+					return nil, err
+				}
+				return tree.DZero, nil
+			},
+			Info: "Truncates the large object referenced by the given descriptor to " +
+				"`len` bytes, extending it with zeros if it is shorter.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_unlink": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lobj", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Int4),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				ds, err := largeObjectDescriptors(ctx, evalCtx)
+				if err != nil {
+					return nil, err
+				}
+				loid := tree.MustBeDOid(args[0]).Oid
+				if err := loCheckPrivilege(ctx, evalCtx, loid, true /* unlink */); err != nil {
+					return nil, err
+				}
+				for _, stmt := range []string{
+					`DELETE FROM system.public.large_object_metadata WHERE loid = $1`,
+					`DELETE FROM system.public.large_objects WHERE loid = $1`,
+				} {
+					if _, err := evalCtx.Planner.QueryRowEx(
+						ctx, "lo-unlink", sessiondata.NodeUserSessionDataOverride, stmt, tree.NewDOid(loid),
+					); err != nil {
+						return nil, err
+					}
+				}
+				ds.CloseAll(loid)
+				return tree.NewDInt(1), nil
+			},
+			Info:       "Deletes the given large object.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_get": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lobj", Typ: types.Oid}},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				loid := tree.MustBeDOid(args[0]).Oid
+				if err := loCheckPrivilege(ctx, evalCtx, loid, false /* unlink */); err != nil {
+					return nil, err
+				}
+				data, err := loRead(ctx, evalCtx, loid, 0 /* offset */, math.MaxInt64)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDBytes(tree.DBytes(data)), nil
+			},
+			Info:       "Returns the contents of the given large object.",
+			Volatility: volatility.Volatile,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "lobj", Typ: types.Oid},
+				{Name: "offset", Typ: types.Int},
+				{Name: "len", Typ: types.Int4},
+			},
+			ReturnType: tree.FixedReturnType(types.Bytes),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				offset, length := int64(tree.MustBeDInt(args[1])), int64(tree.MustBeDInt(args[2]))
+				if offset < 0 || length < 0 {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+						"requested offset and length cannot be negative")
+				}
+				loid := tree.MustBeDOid(args[0]).Oid
+				if err := loCheckPrivilege(ctx, evalCtx, loid, false /* unlink */); err != nil {
+					return nil, err
+				}
+				data, err := loRead(ctx, evalCtx, loid, offset, length)
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDBytes(tree.DBytes(data)), nil
+			},
+			Info:       "Returns up to `len` bytes of the given large object, starting at `offset`.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_put": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "lobj", Typ: types.Oid},
+				{Name: "offset", Typ: types.Int},
+				{Name: "data", Typ: types.Bytes},
+			},
+			ReturnType: tree.FixedReturnType(types.Void),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				loid, offset := tree.MustBeDOid(args[0]).Oid, int64(tree.MustBeDInt(args[1]))
+				if offset < 0 {
+					return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+						"requested offset cannot be negative")
+				}
+				if err := loCheckPrivilege(ctx, evalCtx, loid, false /* unlink */); err != nil {
+					return nil, err
+				}
+				if err := loWrite(ctx, evalCtx, loid, offset, []byte(tree.MustBeDBytes(args[2]))); err != nil {
+					return nil, err
+				}
+				return tree.DVoidDatum, nil
+			},
+			Info:       "Writes the given data into the given large object, starting at `offset`.",
+			Volatility: volatility.Volatile,
+		},
+	),
+
+	"lo_from_bytea": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lobj", Typ: types.Oid}, {Name: "data", Typ: types.Bytes}},
+			ReturnType: tree.FixedReturnType(types.Oid),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				if _, err := largeObjectDescriptors(ctx, evalCtx); err != nil {
+					return nil, err
+				}
+				loid, err := loCreate(ctx, evalCtx, tree.MustBeDOid(args[0]).Oid)
+				if err != nil {
+					return nil, err
+				}
+				if err := loWrite(ctx, evalCtx, loid, 0 /* offset */, []byte(tree.MustBeDBytes(args[1]))); err != nil {
+					return nil, err
+				}
+				return tree.NewDOid(loid), nil
+			},
+			Info: "Creates a large object with the given OID and contents and returns its OID. " +
+				"If the OID is 0, an unused OID is assigned.",
+			Volatility: volatility.Volatile,
+		},
+	),
+}
+
+func loSeekOverload(offsetTyp, returnTyp *types.T) tree.Overload {
+	return tree.Overload{
+		Types: tree.ParamTypes{
+			{Name: "fd", Typ: types.Int4},
+			{Name: "offset", Typ: offsetTyp},
+			{Name: "whence", Typ: types.Int4},
+		},
+		ReturnType: tree.FixedReturnType(returnTyp),
+		Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+			d, err := largeObjectDescriptor(ctx, evalCtx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			var size int64
+			whence := int32(tree.MustBeDInt(args[2]))
+			if whence == largeobject.SeekEnd {
+				if size, err = loSize(ctx, evalCtx, d.OID); err != nil {
+					return nil, err
+				}
+			}
+			offset, err := d.Seek(int64(tree.MustBeDInt(args[1])), whence, size)
+			if err != nil {
+				return nil, err
+			}
+			if returnTyp.Width() == 32 && offset > math.MaxInt32 {
+				return nil, pgerror.Newf(pgcode.NumericValueOutOfRange,
+					"lo_lseek result out of range for large-object descriptor %d", tree.MustBeDInt(args[0]))
+			}
+			return tree.NewDInt(tree.DInt(offset)), nil
+		},
+		Info: "Moves the current position of the given large-object descriptor and " +
+			"returns the new position. `whence` is 0 (SEEK_SET), 1 (SEEK_CUR) or 2 (SEEK_END).",
+		Volatility: volatility.Volatile,
+	}
+}
+
+func loTellOverload(returnTyp *types.T) tree.Overload {
+	return tree.Overload{
+		Types:      tree.ParamTypes{{Name: "fd", Typ: types.Int4}},
+		ReturnType: tree.FixedReturnType(returnTyp),
+		Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+			d, err := largeObjectDescriptor(ctx, evalCtx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			if returnTyp.Width() == 32 && d.Offset > math.MaxInt32 {
+				return nil, pgerror.Newf(pgcode.NumericValueOutOfRange,
+					"lo_tell result out of range for large-object descriptor %d", tree.MustBeDInt(args[0]))
+			}
+			return tree.NewDInt(tree.DInt(d.Offset)), nil
+		},
+		Info:       "Returns the current position of the given large-object descriptor.",
+		Volatility: volatility.Volatile,
+	}
+}
+
+// firstLargeObjectOID is the smallest OID assigned by lo_create(0). It
+// matches FirstNormalObjectId in Postgres.
+const firstLargeObjectOID = 16384
+
+// maxLargeObjectOIDAttempts is the number of unused OIDs lo_create(0) tries
+// to assign before giving up.
+const maxLargeObjectOIDAttempts = 10
+
+// largeObjectDescriptors returns the large-object descriptors of the current
+// transaction, or an error if large objects cannot be used.
+func largeObjectDescriptors(
+	ctx context.Context, evalCtx *eval.Context,
+) (*largeobject.Descriptors, error) {
+	// This check can be removed when mixed-version support with v24.3 is no
+	// longer necessary.
+	if !evalCtx.Settings.Version.IsActive(ctx, clusterversion.V25_1_LargeObjectsTable) {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"large objects unsupported in mixed-version cluster")
+	}
+	if evalCtx.LargeObjects == nil || evalCtx.Planner == nil {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"large objects are not supported in this context")
+	}
+	return evalCtx.LargeObjects, nil
+}
+
+// largeObjectDescriptor returns the open large-object descriptor identified
+// by fd.
+func largeObjectDescriptor(
+	ctx context.Context, evalCtx *eval.Context, fd tree.Datum,
+) (*largeobject.Descriptor, error) {
+	ds, err := largeObjectDescriptors(ctx, evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	return ds.Get(int32(tree.MustBeDInt(fd)))
+}
+
+func errLargeObjectDoesNotExist(loid oid.Oid) error {
+	return pgerror.Newf(pgcode.UndefinedObject, "large object %d does not exist", loid)
+}
+
+// loCheckPrivilege returns an error if the given large object does not exist
+// or if the current user is not allowed to access it. Only the owner of the
+// large object, the members of the owning role and admins are allowed. unlink
+// selects the error message used by lo_unlink.
+func loCheckPrivilege(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, unlink bool,
+) error {
+	row, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-owner", sessiondata.NodeUserSessionDataOverride,
+		`SELECT owner FROM system.public.large_object_metadata WHERE loid = $1`,
+		tree.NewDOid(loid),
+	)
+	if err != nil {
+		return err
+	}
+	if row == nil {
+		return errLargeObjectDoesNotExist(loid)
+	}
+	owner := username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[0])))
+	user := evalCtx.SessionData().User()
+	if user == owner {
+		return nil
+	}
+	if isAdmin, err := evalCtx.Planner.UserHasAdminRole(ctx, user); err != nil {
+		return err
+	} else if isAdmin {
+		return nil
+	}
+	memberOf, err := evalCtx.Planner.MemberOfWithAdminOption(ctx, user)
+	if err != nil {
+		return err
+	}
+	if _, ok := memberOf[owner]; ok {
+		return nil
+	}
+	if unlink {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "must be owner of large object %d", loid)
+	}
+	return pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for large object %d", loid)
+}
+
+// loCreate creates an empty large object owned by the current user and
+// returns its OID. If loid is 0, an unused OID is assigned.
+func loCreate(ctx context.Context, evalCtx *eval.Context, loid oid.Oid) (oid.Oid, error) {
+	owner := evalCtx.SessionData().User()
+	if loid != 0 {
+		if created, err := loCreateMetadata(ctx, evalCtx, loid, owner); err != nil {
+			return 0, err
+		} else if !created {
+			return 0, pgerror.Newf(pgcode.DuplicateObject, "large object %d already exists", loid)
+		}
+	} else {
+		// OIDs are picked at random, like unique_rowid() picks row IDs, so that
+		// concurrent transactions creating large objects do not contend on the
+		// same OID. Conflicts with existing large objects are retried.
+		for attempt := 0; loid == 0; attempt++ {
+			if attempt == maxLargeObjectOIDAttempts {
+				return 0, pgerror.New(pgcode.ProgramLimitExceeded, "no large object OIDs available")
+			}
+			candidate := oid.Oid(firstLargeObjectOID + rand.Int63n(math.MaxUint32-firstLargeObjectOID+1))
+			created, err := loCreateMetadata(ctx, evalCtx, candidate, owner)
+			if err != nil {
+				return 0, err
+			}
+			if created {
+				loid = candidate
+			}
+		}
+	}
+	// Page 0 always exists, so that the size of empty large objects can be
+	// computed.
+	if _, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-create", sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.public.large_objects (loid, pageno, data) VALUES ($1, 0, '')`,
+		tree.NewDOid(loid),
+	); err != nil {
+		return 0, err
+	}
+	return loid, nil
+}
+
+// loCreateMetadata records the owner of a new large object. It returns false
+// if a large object with the given OID already exists.
+func loCreateMetadata(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, owner username.SQLUsername,
+) (bool, error) {
+	row, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-create", sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.public.large_object_metadata (loid, owner) VALUES ($1, $2)
+ON CONFLICT (loid) DO NOTHING RETURNING loid`,
+		tree.NewDOid(loid), tree.NewDString(owner.Normalized()),
+	)
+	if err != nil {
+		return false, err
+	}
+	return row != nil, nil
+}
+
+// loSize returns the size, in bytes, of the given large object.
+func loSize(ctx context.Context, evalCtx *eval.Context, loid oid.Oid) (int64, error) {
+	row, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-size", sessiondata.NodeUserSessionDataOverride,
+		`SELECT pageno, length(data) FROM system.public.large_objects
+WHERE loid = $1 ORDER BY pageno DESC LIMIT 1`,
+		tree.NewDOid(loid),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if row == nil {
+		return 0, errLargeObjectDoesNotExist(loid)
+	}
+	return int64(tree.MustBeDInt(row[0]))*largeobject.PageSize + int64(tree.MustBeDInt(row[1])), nil
+}
+
+// loRead returns up to length bytes of the given large object, starting at
+// offset. Pages that were never written are read as zeros.
+func loRead(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, offset, length int64,
+) (_ []byte, retErr error) {
+	size, err := loSize(ctx, evalCtx, loid)
+	if err != nil {
+		return nil, err
+	}
+	length = min(length, size-offset)
+	if length <= 0 {
+		return []byte{}, nil
+	}
+	pages := largeobject.Pages(offset, length)
+	it, err := evalCtx.Planner.QueryIteratorEx(
+		ctx, "lo-read", sessiondata.NodeUserSessionDataOverride,
+		`SELECT pageno, data FROM system.public.large_objects
+WHERE loid = $1 AND pageno BETWEEN $2 AND $3`,
+		tree.NewDOid(loid),
+		tree.NewDInt(tree.DInt(pages[0].PageNo)),
+		tree.NewDInt(tree.DInt(pages[len(pages)-1].PageNo)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { retErr = errors.CombineErrors(retErr, it.Close()) }()
+	buf := make([]byte, length)
+	var ok bool
+	for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+		row := it.Cur()
+		pageStart := int64(tree.MustBeDInt(row[0])) * largeobject.PageSize
+		data := []byte(tree.MustBeDBytes(row[1]))
+		lo := max(offset-pageStart, 0)
+		hi := min(offset+length-pageStart, int64(len(data)))
+		if lo < hi {
+			copy(buf[pageStart+lo-offset:], data[lo:hi])
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// loWrite writes data into the given large object, starting at offset. The
+// large object must exist.
+func loWrite(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, offset int64, data []byte,
+) error {
+	if offset > largeobject.MaxSize-int64(len(data)) {
+		return pgerror.Newf(pgcode.InvalidParameterValue,
+			"invalid large object write request size: %d", len(data))
+	}
+	for _, p := range largeobject.Pages(offset, int64(len(data))) {
+		page, err := loReadPage(ctx, evalCtx, loid, p.PageNo)
+		if err != nil {
+			return err
+		}
+		if len(page) < p.End {
+			page = append(page, make([]byte, p.End-len(page))...)
+		}
+		n := copy(page[p.Start:p.End], data)
+		data = data[n:]
+		if err := loWritePage(ctx, evalCtx, loid, p.PageNo, page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loTruncate truncates or extends the given large object to length bytes.
+func loTruncate(ctx context.Context, evalCtx *eval.Context, loid oid.Oid, length int64) error {
+	size, err := loSize(ctx, evalCtx, loid)
+	if err != nil {
+		return err
+	}
+	switch {
+	case length > size:
+		// Writing the last byte extends the large object. The bytes in between
+		// are read as zeros.
+		return loWrite(ctx, evalCtx, loid, length-1, []byte{0})
+	case length < size:
+		lastPage := int32(max(length-1, 0) / largeobject.PageSize)
+		if _, err := evalCtx.Planner.QueryRowEx(
+			ctx, "lo-truncate", sessiondata.NodeUserSessionDataOverride,
+			`DELETE FROM system.public.large_objects WHERE loid = $1 AND pageno > $2`,
+			tree.NewDOid(loid), tree.NewDInt(tree.DInt(lastPage)),
+		); err != nil {
+			return err
+		}
+		page, err := loReadPage(ctx, evalCtx, loid, lastPage)
+		if err != nil {
+			return err
+		}
+		if end := int(length - int64(lastPage)*largeobject.PageSize); len(page) > end {
+			page = page[:end]
+		} else {
+			page = append(page, make([]byte, end-len(page))...)
+		}
+		return loWritePage(ctx, evalCtx, loid, lastPage, page)
+	}
+	return nil
+}
+
+// loReadPage returns the contents of the given page of a large object, or nil
+// if the page doesn't exist.
+func loReadPage(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, pageNo int32,
+) ([]byte, error) {
+	row, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-read-page", sessiondata.NodeUserSessionDataOverride,
+		`SELECT data FROM system.public.large_objects WHERE loid = $1 AND pageno = $2`,
+		tree.NewDOid(loid), tree.NewDInt(tree.DInt(pageNo)),
+	)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return []byte(tree.MustBeDBytes(row[0])), nil
+}
+
+func loWritePage(
+	ctx context.Context, evalCtx *eval.Context, loid oid.Oid, pageNo int32, page []byte,
+) error {
+	_, err := evalCtx.Planner.QueryRowEx(
+		ctx, "lo-write-page", sessiondata.NodeUserSessionDataOverride,
+		`UPSERT INTO system.public.large_objects (loid, pageno, data) VALUES ($1, $2, $3)`,
+		tree.NewDOid(loid), tree.NewDInt(tree.DInt(pageNo)), tree.NewDBytes(tree.DBytes(page)),
+	)
+	return err
+}
@@ -134,4 +134,39 @@ var pgvectorBuiltins = map[string]builtinDefinition{
 			Volatility: volatility.Immutable,
 		},
 	),
+	"l2_normalize": makeBuiltin(defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.PGVector},
+			},
+			ReturnType: tree.FixedReturnType(types.PGVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				v1 := tree.MustBeDPGVector(args[0])
+				return tree.NewDPGVector(vector.L2Normalize(v1.T)), nil
+			},
+			Info:       "Returns the vector scaled to have a Euclidean norm of 1.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"subvector": makeBuiltin(defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.PGVector},
+				{Name: "start", Typ: types.Int},
+				{Name: "count", Typ: types.Int},
+			},
+			ReturnType: tree.FixedReturnType(types.PGVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				v1 := tree.MustBeDPGVector(args[0])
+				start, count := tree.MustBeDInt(args[1]), tree.MustBeDInt(args[2])
+				v, err := vector.Subvector(v1.T, int64(start), int64(count))
+				if err != nil {
+					return nil, err
+				}
+				return tree.NewDPGVector(v), nil
+			},
+			Info:       "Returns the `count` dimensions of the vector starting at the 1-based position `start`.",
+			Volatility: volatility.Immutable,
+		},
+	),
 }
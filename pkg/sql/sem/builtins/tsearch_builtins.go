@@ -229,6 +229,211 @@ var tsearchBuiltins = map[string]builtinDefinition{
 			Volatility: volatility.Stable,
 		},
 	),
+	"websearch_to_tsquery": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "config", Typ: types.String}, {Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.TSQuery),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config := string(tree.MustBeDString(args[0]))
+				input := string(tree.MustBeDString(args[1]))
+				query, err := tsearch.WebSearchToTSQuery(config, input)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSQuery{TSQuery: query}, nil
+			},
+			Info: "Converts text written in web search syntax to a tsquery, normalizing words according to " +
+				"the specified configuration. Unquoted words are connected by the & operator, quoted text is " +
+				"connected by the <-> operator, \"or\" is converted to the | operator and a leading - to the ! operator.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "text", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.TSQuery),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				config := tsearch.GetConfigKey(evalCtx.SessionData().DefaultTextSearchConfig)
+				input := string(tree.MustBeDString(args[0]))
+				query, err := tsearch.WebSearchToTSQuery(config, input)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSQuery{TSQuery: query}, nil
+			},
+			Info: "Converts text written in web search syntax to a tsquery, normalizing words according to " +
+				"the default configuration. Unquoted words are connected by the & operator, quoted text is " +
+				"connected by the <-> operator, \"or\" is converted to the | operator and a leading - to the ! operator.",
+			Volatility: volatility.Stable,
+		},
+	),
+	"setweight": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}, {Name: "weight", Typ: types.QChar}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector, err := tsearch.SetWeight(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					string(tree.MustBeDString(args[1])),
+					nil, /* lexemes */
+				)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info:       "Returns a copy of the input vector with the given weight assigned to each position.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "weight", Typ: types.QChar},
+				{Name: "lexemes", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				lexemes, err := tsearchStringArray(tree.MustBeDArray(args[2]), "lexeme")
+				if err != nil {
+					return nil, err
+				}
+				vector, err := tsearch.SetWeight(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					string(tree.MustBeDString(args[1])),
+					lexemes,
+				)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info: "Returns a copy of the input vector with the given weight assigned to each position " +
+				"of the given lexemes.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"strip": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector := tsearch.Strip(tree.MustBeDTSVector(args[0]).TSVector)
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info:       "Returns a copy of the input vector without positions and weights.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"ts_delete": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}, {Name: "lexeme", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				vector := tsearch.Delete(
+					tree.MustBeDTSVector(args[0]).TSVector,
+					[]string{string(tree.MustBeDString(args[1]))},
+				)
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info:       "Returns a copy of the input vector without any occurrence of the given lexeme.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}, {Name: "lexemes", Typ: types.StringArray}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				arr := tree.MustBeDArray(args[1])
+				lexemes := make([]string, 0, arr.Len())
+				for _, d := range arr.Array {
+					// NULL elements are ignored.
+					if d != tree.DNull {
+						lexemes = append(lexemes, string(tree.MustBeDString(d)))
+					}
+				}
+				vector := tsearch.Delete(tree.MustBeDTSVector(args[0]).TSVector, lexemes)
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info:       "Returns a copy of the input vector without any occurrence of the given lexemes.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"ts_filter": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "vector", Typ: types.TSVector},
+				{Name: "weights", Typ: types.MakeArray(types.QChar)},
+			},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				weights, err := tsearchStringArray(tree.MustBeDArray(args[1]), "weight")
+				if err != nil {
+					return nil, err
+				}
+				vector, err := tsearch.Filter(tree.MustBeDTSVector(args[0]).TSVector, weights)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info: "Returns a copy of the input vector that only contains the positions with the given weights. " +
+				"Lexemes without any such position are removed.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"tsvector_to_array": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "vector", Typ: types.TSVector}},
+			ReturnType: tree.FixedReturnType(types.StringArray),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				arr := tree.NewDArray(types.String)
+				for _, lexeme := range tsearch.Lexemes(tree.MustBeDTSVector(args[0]).TSVector) {
+					if err := arr.Append(tree.NewDString(lexeme)); err != nil {
+						return nil, err
+					}
+				}
+				return arr, nil
+			},
+			Info:       "Returns the lexemes of the input vector as an array.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"array_to_tsvector": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "lexemes", Typ: types.StringArray}},
+			ReturnType: tree.FixedReturnType(types.TSVector),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				lexemes, err := tsearchStringArray(tree.MustBeDArray(args[0]), "lexeme")
+				if err != nil {
+					return nil, err
+				}
+				vector, err := tsearch.LexemesToTSVector(lexemes)
+				if err != nil {
+					return nil, err
+				}
+				return &tree.DTSVector{TSVector: vector}, nil
+			},
+			Info: "Converts an array of lexemes to a tsvector. The lexemes are used as is, " +
+				"without any normalization.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"numnode": makeBuiltin(
+		tree.FunctionProperties{},
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "query", Typ: types.TSQuery}},
+			ReturnType: tree.FixedReturnType(types.Int),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return tree.NewDInt(tree.DInt(tsearch.NumNodes(tree.MustBeDTSQuery(args[0]).TSQuery))), nil
+			},
+			Info:       "Returns the number of lexemes and operators in the input query.",
+			Volatility: volatility.Immutable,
+		},
+	),
 	"ts_rank": makeBuiltin(
 		tree.FunctionProperties{},
 		tree.Overload{
@@ -343,3 +548,16 @@ func getWeights(arr *tree.DArray) ([]float32, error) {
 	}
 	return ret, nil
 }
+
+// tsearchStringArray returns the elements of the given array, which must not
+// contain NULLs. what describes the elements in the error message.
+func tsearchStringArray(arr *tree.DArray, what string) ([]string, error) {
+	ret := make([]string, len(arr.Array))
+	for i, d := range arr.Array {
+		if d == tree.DNull {
+			return nil, pgerror.Newf(pgcode.NullValueNotAllowed, "%s array may not contain nulls", what)
+		}
+		ret[i] = string(tree.MustBeDString(d))
+	}
+	return ret, nil
+}
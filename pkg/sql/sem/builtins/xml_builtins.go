@@ -0,0 +1,193 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package builtins
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
+)
+
+func init() {
+	for k, v := range xmlBuiltins {
+		v.props.Category = builtinconstants.CategoryXML
+		const enforceClass = true
+		registerBuiltin(k, v, tree.NormalClass, enforceClass)
+	}
+}
+
+// The XML builtins operate on XML values, which are stored as text that has
+// been validated as well-formed XML content. Strings can be passed wherever an
+// XML value is expected, and errors report the line and column at which the
+// value is malformed.
+var xmlBuiltins = map[string]builtinDefinition{
+	"xpath": makeBuiltin(defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+			},
+			ReturnType: tree.FixedReturnType(types.MakeArray(types.XML)),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpathImpl(args, nil /* namespaces */)
+			},
+			Info: "Evaluates the XPath 1.0 expression against the XML document. " +
+				"Returns the serialized nodes of the resulting node-set, or the " +
+				"result as a single element if it is not a node-set.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+				{Name: "nsarray", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.MakeArray(types.XML)),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				namespaces, err := parseXMLNamespaces(tree.MustBeDArray(args[2]))
+				if err != nil {
+					return nil, err
+				}
+				return xpathImpl(args, namespaces)
+			},
+			Info: "Evaluates the XPath 1.0 expression against the XML document. " +
+				"nsarray lists the namespaces that can be used in the expression, " +
+				"as alternating prefixes and namespace URIs. Returns the serialized " +
+				"nodes of the resulting node-set, or the result as a single element " +
+				"if it is not a node-set.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"xpath_exists": makeBuiltin(defProps(),
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+			},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				return xpathExistsImpl(args, nil /* namespaces */)
+			},
+			Info: "Returns whether the XPath 1.0 expression evaluated against the " +
+				"XML document selects at least one node.",
+			Volatility: volatility.Immutable,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "xpath", Typ: types.String},
+				{Name: "xml", Typ: types.XML},
+				{Name: "nsarray", Typ: types.StringArray},
+			},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				namespaces, err := parseXMLNamespaces(tree.MustBeDArray(args[2]))
+				if err != nil {
+					return nil, err
+				}
+				return xpathExistsImpl(args, namespaces)
+			},
+			Info: "Returns whether the XPath 1.0 expression evaluated against the " +
+				"XML document selects at least one node. nsarray lists the " +
+				"namespaces that can be used in the expression, as alternating " +
+				"prefixes and namespace URIs.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"xml_is_well_formed": makeBuiltin(defProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xml", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				s := string(tree.MustBeDString(args[0]))
+				return tree.MakeDBool(tree.DBool(xmlutil.IsWellFormedContent(s))), nil
+			},
+			Info:       "Returns whether the string is well-formed XML content.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"xml_is_well_formed_document": makeBuiltin(defProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xml", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				s := string(tree.MustBeDString(args[0]))
+				return tree.MakeDBool(tree.DBool(xmlutil.IsWellFormedDocument(s))), nil
+			},
+			Info: "Returns whether the string is a well-formed XML document, " +
+				"which has a single root element.",
+			Volatility: volatility.Immutable,
+		},
+	),
+	"xml_is_well_formed_content": makeBuiltin(defProps(),
+		tree.Overload{
+			Types:      tree.ParamTypes{{Name: "xml", Typ: types.String}},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(_ context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				s := string(tree.MustBeDString(args[0]))
+				return tree.MakeDBool(tree.DBool(xmlutil.IsWellFormedContent(s))), nil
+			},
+			Info:       "Returns whether the string is well-formed XML content.",
+			Volatility: volatility.Immutable,
+		},
+	),
+}
+
+func xpathImpl(args tree.Datums, namespaces map[string]string) (tree.Datum, error) {
+	doc, err := xmlutil.ParseDocument(string(tree.MustBeDString(args[1])))
+	if err != nil {
+		return nil, err
+	}
+	res, err := xmlutil.XPath(string(tree.MustBeDString(args[0])), doc, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	arr := tree.NewDArray(types.XML)
+	for _, s := range res {
+		if err := arr.Append(tree.NewDString(s)); err != nil {
+			return nil, err
+		}
+	}
+	return arr, nil
+}
+
+func xpathExistsImpl(args tree.Datums, namespaces map[string]string) (tree.Datum, error) {
+	doc, err := xmlutil.ParseDocument(string(tree.MustBeDString(args[1])))
+	if err != nil {
+		return nil, err
+	}
+	exists, err := xmlutil.XPathExists(string(tree.MustBeDString(args[0])), doc, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	return tree.MakeDBool(tree.DBool(exists)), nil
+}
+
+// parseXMLNamespaces returns the namespace mapping described by the nsarray
+// argument of the XPath builtins. Postgres takes a two-dimensional array of
+// prefix and URI pairs; since multi-dimensional arrays are not supported, the
+// pairs are flattened into a single array instead.
+func parseXMLNamespaces(arr *tree.DArray) (map[string]string, error) {
+	if arr.HasNulls || arr.Len()%2 != 0 {
+		return nil, pgerror.New(pgcode.DataException, "invalid array for XML namespace mapping")
+	}
+	namespaces := make(map[string]string, arr.Len()/2)
+	for i := 0; i < arr.Len(); i += 2 {
+		prefix := string(tree.MustBeDString(arr.Array[i]))
+		uri := string(tree.MustBeDString(arr.Array[i+1]))
+		if prefix == "" || uri == "" {
+			return nil, pgerror.New(pgcode.DataException, "invalid array for XML namespace mapping")
+		}
+		namespaces[prefix] = uri
+	}
+	return namespaces, nil
+}
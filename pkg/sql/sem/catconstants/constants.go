@@ -107,6 +107,9 @@ const (
 	TxnExecInsightsTableName               SystemTableName = "transaction_execution_insights"
 	TableMetadata                          SystemTableName = "table_metadata"
 	PreparedTransactionsTableName          SystemTableName = "prepared_transactions"
+	NotificationsTableName                 SystemTableName = "notifications"
+	LargeObjectsTableName                  SystemTableName = "large_objects"
+	LargeObjectMetadataTableName           SystemTableName = "large_object_metadata"
 )
 
 // Oid for virtual database and table.
@@ -1072,6 +1072,28 @@ func (e *evaluator) EvalModStringOp(
 	return tree.MakeDBool(f >= e.ctx().SessionData().TrigramSimilarityThreshold), nil
 }
 
+func (e *evaluator) EvalWordSimilarStringOp(
+	ctx context.Context, _ *tree.WordSimilarStringOp, left, right tree.Datum,
+) (tree.Datum, error) {
+	// The string <% string operator returns whether the word_similarity() of
+	// the two strings is greater or equal than the threshold in
+	// pg_trgm.word_similarity_threshold.
+	l, r := tree.MustBeDString(left), tree.MustBeDString(right)
+	f := trigram.WordSimilarity(string(l), string(r))
+	return tree.MakeDBool(f >= e.ctx().SessionData().TrigramWordSimilarityThreshold), nil
+}
+
+func (e *evaluator) EvalStrictWordSimilarStringOp(
+	ctx context.Context, _ *tree.StrictWordSimilarStringOp, left, right tree.Datum,
+) (tree.Datum, error) {
+	// The string <<% string operator returns whether the
+	// strict_word_similarity() of the two strings is greater or equal than the
+	// threshold in pg_trgm.strict_word_similarity_threshold.
+	l, r := tree.MustBeDString(left), tree.MustBeDString(right)
+	f := trigram.StrictWordSimilarity(string(l), string(r))
+	return tree.MakeDBool(f >= e.ctx().SessionData().TrigramStrictWordSimilarityThreshold), nil
+}
+
 func (e *evaluator) EvalMultDecimalIntOp(
 	ctx context.Context, _ *tree.MultDecimalIntOp, left, right tree.Datum,
 ) (tree.Datum, error) {
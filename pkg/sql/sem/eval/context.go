@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/largeobject"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirecancel"
@@ -170,6 +171,11 @@ type Context struct {
 
 	PreparedStatementState PreparedStatementState
 
+	// LargeObjects contains the large-object descriptors opened by the current
+	// transaction. It is nil if large objects are not supported in this context
+	// (for example, for the internal executor).
+	LargeObjects *largeobject.Descriptors
+
 	// The transaction in which the statement is executing.
 	Txn *kv.Txn
 
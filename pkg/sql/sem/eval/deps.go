@@ -313,6 +313,10 @@ type Planner interface {
 	// into the current session.
 	DeserializeSessionState(ctx context.Context, state *tree.DBytes) (*tree.DBool, error)
 
+	// SessionTraceJSON returns the current session trace, as collected by SET
+	// TRACING, in JSON form.
+	SessionTraceJSON() (*tree.DJSON, error)
+
 	// CreateSessionRevivalToken creates a token that can be used to log in
 	// as the current user, in bytes form.
 	CreateSessionRevivalToken() (*tree.DBytes, error)
@@ -448,6 +452,10 @@ type Planner interface {
 
 	// ClearTableStatsCache removes all entries from the node's table stats cache.
 	ClearTableStatsCache()
+
+	// SendNotification sends a notification on the given channel, as NOTIFY
+	// does. The notification is delivered if the current transaction commits.
+	SendNotification(ctx context.Context, channel, payload string) error
 }
 
 // InternalRows is an iterator interface that's exposed by the internal
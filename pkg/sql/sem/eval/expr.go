@@ -302,6 +302,47 @@ func (e *evaluator) EvalIndexedVar(ctx context.Context, iv *tree.IndexedVar) (tr
 	return eivc.IndexedVarEval(iv.Idx)
 }
 
+// evalArraySlice returns the elements of the array between the bounds of the
+// slice, inclusive. Omitted bounds default to the bounds of the array, and
+// bounds outside of the array are clamped to it, as in Postgres.
+func (e *evaluator) evalArraySlice(
+	ctx context.Context, arr *tree.DArray, slice *tree.ArraySubscript,
+) (tree.Datum, error) {
+	// VECTOR types use 0-indexing.
+	lower := arr.FirstIndex()
+	upper := lower + arr.Len() - 1
+	evalBound := func(bound tree.Expr, def int) (tree.Datum, int, error) {
+		if bound == nil {
+			return nil, def, nil
+		}
+		d, err := bound.(tree.TypedExpr).Eval(ctx, e)
+		if err != nil || d == tree.DNull {
+			return d, 0, err
+		}
+		return d, int(tree.MustBeDInt(d)), nil
+	}
+	beginDatum, begin, err := evalBound(slice.Begin, lower)
+	if err != nil {
+		return nil, err
+	}
+	endDatum, end, err := evalBound(slice.End, upper)
+	if err != nil {
+		return nil, err
+	}
+	if beginDatum == tree.DNull || endDatum == tree.DNull {
+		return tree.DNull, nil
+	}
+	begin = max(begin, lower)
+	end = min(end, upper)
+	res := tree.NewDArray(arr.ParamTyp)
+	for i := begin; i <= end; i++ {
+		if err := res.Append(arr.Array[i-lower]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (e *evaluator) EvalIndirectionExpr(
 	ctx context.Context, expr *tree.IndirectionExpr,
 ) (tree.Datum, error) {
@@ -318,9 +359,12 @@ func (e *evaluator) EvalIndirectionExpr(
 	switch d.ResolvedType().Family() {
 	case types.ArrayFamily:
 		for i, t := range expr.Indirection {
-			if t.Slice || i > 0 {
+			if i > 0 {
 				return nil, errors.AssertionFailedf("unsupported feature should have been rejected during planning")
 			}
+			if t.Slice {
+				return e.evalArraySlice(ctx, tree.MustBeDArray(d), t)
+			}
 
 			beginDatum, err := t.Begin.(tree.TypedExpr).Eval(ctx, e)
 			if err != nil {
@@ -0,0 +1,22 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// AlterExternalConnection represents an ALTER EXTERNAL CONNECTION statement.
+type AlterExternalConnection struct {
+	ConnectionLabel Expr
+	As              Expr
+}
+
+var _ Statement = &AlterExternalConnection{}
+
+// Format implements the Statement interface.
+func (node *AlterExternalConnection) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER EXTERNAL CONNECTION ")
+	ctx.FormatNode(node.ConnectionLabel)
+	ctx.WriteString(" AS ")
+	ctx.FormatURI(node.As)
+}
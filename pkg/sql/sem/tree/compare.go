@@ -42,6 +42,13 @@ func FoldComparisonExpr(
 	case treecmp.NotRegIMatch:
 		// NotRegIMatch(left, right) is implemented as !RegIMatch(left, right)
 		return treecmp.MakeComparisonOperator(treecmp.RegIMatch), left, right, false, true
+	case treecmp.WordSimilarCommuted:
+		// WordSimilarCommuted(left, right) is implemented as WordSimilar(right, left)
+		return treecmp.MakeComparisonOperator(treecmp.WordSimilar), right, left, true, false
+	case treecmp.StrictWordSimilarCommuted:
+		// StrictWordSimilarCommuted(left, right) is implemented as
+		// StrictWordSimilar(right, left)
+		return treecmp.MakeComparisonOperator(treecmp.StrictWordSimilar), right, left, true, false
 	case treecmp.IsDistinctFrom:
 		// IsDistinctFrom(left, right) is implemented as !IsNotDistinctFrom(left, right)
 		// Note: this seems backwards, but IS NOT DISTINCT FROM is an extended
@@ -85,6 +92,13 @@ func FoldComparisonExprWithDatums(
 	case treecmp.NotRegIMatch:
 		// NotRegIMatch(left, right) is implemented as !RegIMatch(left, right)
 		return treecmp.MakeComparisonOperator(treecmp.RegIMatch), left, right, false, true
+	case treecmp.WordSimilarCommuted:
+		// WordSimilarCommuted(left, right) is implemented as WordSimilar(right, left)
+		return treecmp.MakeComparisonOperator(treecmp.WordSimilar), right, left, true, false
+	case treecmp.StrictWordSimilarCommuted:
+		// StrictWordSimilarCommuted(left, right) is implemented as
+		// StrictWordSimilar(right, left)
+		return treecmp.MakeComparisonOperator(treecmp.StrictWordSimilar), right, left, true, false
 	case treecmp.IsDistinctFrom:
 		// IsDistinctFrom(left, right) is implemented as !IsNotDistinctFrom(left, right)
 		// Note: this seems backwards, but IS NOT DISTINCT FROM is an extended
@@ -18,6 +18,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/intsets"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
 )
@@ -641,6 +642,12 @@ func (expr *StrVal) ResolveAsType(
 			// with logic in those functions.
 			expr.resString = DString(strings.TrimRight(expr.s, " "))
 			return &expr.resString, nil
+		case oid.T_xml:
+			if _, err := xmlutil.ParseContent(expr.s); err != nil {
+				return nil, err
+			}
+			expr.resString = DString(expr.s)
+			return &expr.resString, nil
 		default:
 			expr.resString = DString(expr.s)
 			return &expr.resString, nil
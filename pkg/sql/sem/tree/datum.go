@@ -37,6 +37,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/ipaddr"
 	"github.com/cockroachdb/cockroach/pkg/util/json"
 	"github.com/cockroachdb/cockroach/pkg/util/stringencoding"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/cockroach/pkg/util/timeofday"
 	"github.com/cockroachdb/cockroach/pkg/util/timetz"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
@@ -45,6 +46,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/uint128"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
 	"github.com/cockroachdb/cockroach/pkg/util/vector"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 	"github.com/lib/pq/oid"
@@ -1416,12 +1418,15 @@ func (d *DCollatedString) Compare(
 	return res, nil
 }
 
-// Prev implements the Datum interface.
+// Prev implements the Datum interface. Collated strings are ordered by their
+// collation keys, and there is usually no string whose key immediately
+// precedes or follows the key of another string, so collated strings have no
+// previous or next value.
 func (d *DCollatedString) Prev(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
 	return nil, false
 }
 
-// Next implements the Datum interface.
+// Next implements the Datum interface. See Prev.
 func (d *DCollatedString) Next(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
 	return nil, false
 }
@@ -1431,14 +1436,40 @@ func (*DCollatedString) IsMax(ctx context.Context, cmpCtx CompareContext) bool {
 	return false
 }
 
-// IsMin implements the Datum interface.
+// IsMin implements the Datum interface. Strings consisting only of ignorable
+// characters have the same collation key as the empty string, so the key is
+// checked instead of the contents.
 func (d *DCollatedString) IsMin(ctx context.Context, cmpCtx CompareContext) bool {
-	return d.Contents == ""
+	m, err := minCollatedString(d.Locale)
+	return err == nil && bytes.Equal(d.Key, m.Key)
 }
 
 // Min implements the Datum interface.
 func (d *DCollatedString) Min(ctx context.Context, cmpCtx CompareContext) (Datum, bool) {
-	return &DCollatedString{"", d.Locale, nil}, true
+	m, err := minCollatedString(d.Locale)
+	if err != nil {
+		return nil, false
+	}
+	return m, true
+}
+
+// minCollatedStrings caches the empty string of each locale. The collation
+// key of the empty string is usually not empty, since it contains the
+// separators between the levels of the key.
+var minCollatedStrings syncutil.Map[string, DCollatedString]
+
+// minCollatedString returns the smallest collated string of the given locale.
+func minCollatedString(locale string) (*DCollatedString, error) {
+	if m, ok := minCollatedStrings.Load(locale); ok {
+		return m, nil
+	}
+	var env CollationEnvironment
+	m, err := NewDCollatedString("", locale, &env)
+	if err != nil {
+		return nil, err
+	}
+	m, _ = minCollatedStrings.LoadOrStore(locale, m)
+	return m, nil
 }
 
 // Max implements the Datum interface.
@@ -6272,6 +6303,11 @@ func AdjustValueToType(typ *types.T, inVal Datum) (outVal Datum, err error) {
 		case oid.T_bpchar:
 			// bpchar types truncate trailing whitespace.
 			sv = strings.TrimRight(sv, " ")
+		case oid.T_xml:
+			// xml values must be well-formed XML content.
+			if _, err := xmlutil.ParseContent(sv); err != nil {
+				return nil, err
+			}
 		}
 
 		var overlength int
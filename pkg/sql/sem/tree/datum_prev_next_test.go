@@ -61,3 +61,44 @@ func TestDatumPrevNext(t *testing.T) {
 		}
 	}
 }
+
+// TestCollatedStringPrevNext verifies the bounds of collated strings, whose
+// collation keys are usually not empty even for the empty string.
+func TestCollatedStringPrevNext(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	var evalCtx eval.Context
+	for _, locale := range []string{"en", "und-u-ks-level2", "und-u-ks-level1", "und-u-ks-level1-kc-true"} {
+		t.Run(locale, func(t *testing.T) {
+			empty, err := tree.NewDCollatedString("", locale, &evalCtx.CollationEnv)
+			require.NoError(t, err)
+			require.True(t, empty.IsMin(ctx, &evalCtx))
+			minDatum, ok := empty.Min(ctx, &evalCtx)
+			require.True(t, ok)
+			cmp, err := minDatum.Compare(ctx, &evalCtx, empty)
+			require.NoError(t, err)
+			require.Zero(t, cmp)
+			_, ok = empty.Prev(ctx, &evalCtx)
+			require.False(t, ok)
+
+			// Control characters are ignored by the collation.
+			ignorable, err := tree.NewDCollatedString("\x01", locale, &evalCtx.CollationEnv)
+			require.NoError(t, err)
+			require.True(t, ignorable.IsMin(ctx, &evalCtx))
+
+			a, err := tree.NewDCollatedString("a", locale, &evalCtx.CollationEnv)
+			require.NoError(t, err)
+			require.False(t, a.IsMin(ctx, &evalCtx))
+			// Collated strings have no next or previous value, so exclusive
+			// span boundaries on them stay exclusive.
+			for _, d := range []*tree.DCollatedString{empty, a} {
+				_, ok := d.Next(ctx, &evalCtx)
+				require.False(t, ok)
+			}
+			_, ok = a.Prev(ctx, &evalCtx)
+			require.False(t, ok)
+		})
+	}
+}
@@ -2064,6 +2064,27 @@ var CmpOps = cmpOpFixups(map[treecmp.ComparisonOperatorSymbol]*CmpOpOverloads{
 			Volatility: volatility.Immutable,
 		},
 	}},
+
+	treecmp.WordSimilar: {overloads: []*CmpOp{
+		{
+			LeftType:  types.String,
+			RightType: types.String,
+			EvalOp:    &WordSimilarStringOp{},
+			// This operator is only stable because its result depends on the value
+			// of the pg_trgm.word_similarity_threshold session setting.
+			Volatility: volatility.Stable,
+		},
+	}},
+	treecmp.StrictWordSimilar: {overloads: []*CmpOp{
+		{
+			LeftType:  types.String,
+			RightType: types.String,
+			EvalOp:    &StrictWordSimilarStringOp{},
+			// This operator is only stable because its result depends on the value
+			// of the pg_trgm.strict_word_similarity_threshold session setting.
+			Volatility: volatility.Stable,
+		},
+	}},
 })
 
 func makeBox2DComparisonOperators(op func(lhs, rhs *geo.CartesianBoundingBox) bool) []*CmpOp {
@@ -76,6 +76,12 @@ type TSMatchesVectorQueryOp struct{}
 // TSMatchesQueryVectorOp is a BinaryEvalOp.
 type TSMatchesQueryVectorOp struct{}
 
+// WordSimilarStringOp is a BinaryEvalOp.
+type WordSimilarStringOp struct{}
+
+// StrictWordSimilarStringOp is a BinaryEvalOp.
+type StrictWordSimilarStringOp struct{}
+
 type (
 	// DistanceVectorOp is a BinaryEvalOp.
 	DistanceVectorOp struct{}
@@ -186,8 +186,10 @@ type BinaryOpEvaluator interface {
 	EvalRShiftIntOp(context.Context, *RShiftIntOp, Datum, Datum) (Datum, error)
 	EvalRShiftVarBitIntOp(context.Context, *RShiftVarBitIntOp, Datum, Datum) (Datum, error)
 	EvalSimilarToOp(context.Context, *SimilarToOp, Datum, Datum) (Datum, error)
+	EvalStrictWordSimilarStringOp(context.Context, *StrictWordSimilarStringOp, Datum, Datum) (Datum, error)
 	EvalTSMatchesQueryVectorOp(context.Context, *TSMatchesQueryVectorOp, Datum, Datum) (Datum, error)
 	EvalTSMatchesVectorQueryOp(context.Context, *TSMatchesVectorQueryOp, Datum, Datum) (Datum, error)
+	EvalWordSimilarStringOp(context.Context, *WordSimilarStringOp, Datum, Datum) (Datum, error)
 }
 
 
@@ -926,6 +928,11 @@ func (op *SimilarToOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Dat
 	return e.EvalSimilarToOp(ctx, op, a, b)
 }
 
+// Eval is part of the BinaryEvalOp interface.
+func (op *StrictWordSimilarStringOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Datum, error) {
+	return e.EvalStrictWordSimilarStringOp(ctx, op, a, b)
+}
+
 // Eval is part of the BinaryEvalOp interface.
 func (op *TSMatchesQueryVectorOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Datum, error) {
 	return e.EvalTSMatchesQueryVectorOp(ctx, op, a, b)
@@ -936,3 +943,8 @@ func (op *TSMatchesVectorQueryOp) Eval(ctx context.Context, e OpEvaluator, a, b
 	return e.EvalTSMatchesVectorQueryOp(ctx, op, a, b)
 }
 
+// Eval is part of the BinaryEvalOp interface.
+func (op *WordSimilarStringOp) Eval(ctx context.Context, e OpEvaluator, a, b Datum) (Datum, error) {
+	return e.EvalWordSimilarStringOp(ctx, op, a, b)
+}
+
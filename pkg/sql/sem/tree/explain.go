@@ -117,6 +117,7 @@ const (
 	ExplainFlagShape
 	ExplainFlagViz
 	ExplainFlagRedact
+	ExplainFlagStats
 	numExplainFlags = iota
 )
 
@@ -130,6 +131,7 @@ var explainFlagStrings = [...]string{
 	ExplainFlagShape:   "SHAPE",
 	ExplainFlagViz:     "VIZ",
 	ExplainFlagRedact:  "REDACT",
+	ExplainFlagStats:   "STATS",
 }
 
 var explainFlagStringMap = func() map[string]ExplainFlag {
@@ -274,6 +276,12 @@ func MakeExplain(options []string, stmt Statement) (Statement, error) {
 		}
 	}
 
+	if opts.Flags[ExplainFlagStats] {
+		if opts.Mode != ExplainOpt {
+			return nil, pgerror.Newf(pgcode.Syntax, "the STATS flag can only be used with OPT")
+		}
+	}
+
 	if opts.Flags[ExplainFlagRedact] {
 		// TODO(michae2): Support redaction of other EXPLAIN modes.
 		switch opts.Mode {
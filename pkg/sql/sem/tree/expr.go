@@ -417,6 +417,18 @@ func NewTypedIndirectionExpr(expr, index TypedExpr, typ *types.T) *IndirectionEx
 	return node
 }
 
+// NewTypedArraySliceExpr returns a new IndirectionExpr that slices an array
+// and is verified to be well-typed. begin and end are nil if the respective
+// bound is omitted.
+func NewTypedArraySliceExpr(expr, begin, end TypedExpr, typ *types.T) *IndirectionExpr {
+	node := &IndirectionExpr{
+		Expr:        expr,
+		Indirection: ArraySubscripts{&ArraySubscript{Begin: begin, End: end, Slice: true}},
+	}
+	node.typ = typ
+	return node
+}
+
 // NewTypedCollateExpr returns a new CollateExpr that is verified to be well-typed.
 func NewTypedCollateExpr(expr TypedExpr, locale string) *CollateExpr {
 	node := &CollateExpr{
@@ -772,6 +784,23 @@ func (node PartitionMinVal) Format(ctx *FmtCtx) {
 	ctx.WriteString("MINVALUE")
 }
 
+// GroupingExpr represents a GROUPING(...) expression. It evaluates to an
+// integer bit mask with one bit per argument, the rightmost argument being the
+// least significant bit. A bit is set when the corresponding argument is not
+// part of the grouping set that produced the current row. The optimizer
+// replaces GroupingExpr with a constant for each grouping set of the query;
+// type checking it anywhere else is an error.
+type GroupingExpr struct {
+	Exprs Exprs
+}
+
+// Format implements the NodeFormatter interface.
+func (node *GroupingExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("GROUPING(")
+	ctx.FormatNode(&node.Exprs)
+	ctx.WriteByte(')')
+}
+
 // Placeholder represents a named placeholder.
 type Placeholder struct {
 	Idx PlaceholderIdx
@@ -1757,6 +1786,8 @@ func (node *DVoid) String() string            { return AsString(node) }
 func (node *Exprs) String() string            { return AsString(node) }
 func (node *ArrayFlatten) String() string     { return AsString(node) }
 func (node *FuncExpr) String() string         { return AsString(node) }
+func (node *GroupingExpr) String() string     { return AsString(node) }
+func (node *GroupingSet) String() string      { return AsString(node) }
 func (node *IfExpr) String() string           { return AsString(node) }
 func (node *IfErrExpr) String() string        { return AsString(node) }
 func (node *IndexedVar) String() string       { return AsString(node) }
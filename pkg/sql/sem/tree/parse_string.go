@@ -22,6 +22,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/json"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil/pgdate"
 	"github.com/cockroachdb/cockroach/pkg/util/uuid"
+	"github.com/cockroachdb/cockroach/pkg/util/xmlutil"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq/oid"
 )
@@ -85,6 +86,11 @@ func ParseAndRequireString(
 	case types.CollatedStringFamily:
 		d, err = NewDCollatedString(s, t.Locale(), ctx.GetCollationEnv())
 	case types.StringFamily:
+		if t.Oid() == oid.T_xml {
+			if _, err := xmlutil.ParseContent(s); err != nil {
+				return nil, false, err
+			}
+		}
 		s = truncateString(s, t)
 		return NewDString(s), false, nil
 	case types.TimeFamily:
@@ -251,6 +257,11 @@ func ParseAndRequireStringHandler(
 			err = pgerror.Wrapf(err, pgcode.Syntax, "could not parse JSON")
 		}
 	case types.StringFamily:
+		if t.Oid() == oid.T_xml {
+			if _, err = xmlutil.ParseContent(s); err != nil {
+				break
+			}
+		}
 		s = truncateString(s, t)
 		vh.String(s)
 	case types.TimestampTZFamily:
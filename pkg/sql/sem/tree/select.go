@@ -787,6 +787,42 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+// GroupingSetType is the kind of a GroupingSet.
+type GroupingSetType int
+
+const (
+	// GroupingSetsType represents GROUPING SETS (...).
+	GroupingSetsType GroupingSetType = iota
+	// RollupType represents ROLLUP (...).
+	RollupType
+	// CubeType represents CUBE (...).
+	CubeType
+)
+
+var groupingSetTypeName = [...]string{
+	GroupingSetsType: "GROUPING SETS",
+	RollupType:       "ROLLUP",
+	CubeType:         "CUBE",
+}
+
+// GroupingSet represents a GROUPING SETS, ROLLUP or CUBE item in a GROUP BY
+// clause. For ROLLUP and CUBE, each expression is an ordinary grouping set:
+// either a single expression or a parenthesized list of expressions (a Tuple).
+// For GROUPING SETS, each expression is an ordinary grouping set or a nested
+// GroupingSet.
+type GroupingSet struct {
+	Type  GroupingSetType
+	Exprs Exprs
+}
+
+// Format implements the NodeFormatter interface.
+func (node *GroupingSet) Format(ctx *FmtCtx) {
+	ctx.WriteString(groupingSetTypeName[node.Type])
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Exprs)
+	ctx.WriteByte(')')
+}
+
 // DistinctOn represents a DISTINCT ON clause.
 type DistinctOn []Expr
 
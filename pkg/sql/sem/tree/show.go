@@ -958,6 +958,15 @@ func (node *ShowFullTableScans) Format(ctx *FmtCtx) {
 	ctx.WriteString("SHOW FULL TABLE SCANS")
 }
 
+// ShowProtectedTimestamps represents a SHOW PROTECTED TIMESTAMPS statement.
+type ShowProtectedTimestamps struct {
+}
+
+// Format implements the NodeFormatter interface.
+func (node *ShowProtectedTimestamps) Format(ctx *FmtCtx) {
+	ctx.WriteString("SHOW PROTECTED TIMESTAMPS")
+}
+
 // ShowSavepointStatus represents a SHOW SAVEPOINT STATUS statement.
 type ShowSavepointStatus struct {
 }
@@ -705,7 +705,12 @@ func (expr *CastExpr) TypeCheck(
 func (expr *IndirectionExpr) TypeCheck(
 	ctx context.Context, semaCtx *SemaContext, desired *types.T,
 ) (TypedExpr, error) {
-	subExpr, err := expr.Expr.TypeCheck(ctx, semaCtx, types.MakeArray(desired))
+	desiredArray := types.MakeArray(desired)
+	if len(expr.Indirection) == 1 && expr.Indirection[0].Slice {
+		// A slice has the same type as the array it is taken from.
+		desiredArray = desired
+	}
+	subExpr, err := expr.Expr.TypeCheck(ctx, semaCtx, desiredArray)
 	if err != nil {
 		return nil, err
 	}
@@ -716,18 +721,28 @@ func (expr *IndirectionExpr) TypeCheck(
 	case types.ArrayFamily:
 		expr.typ = typ.ArrayContents()
 		for i, t := range expr.Indirection {
-			if t.Slice {
-				return nil, unimplemented.NewWithIssuef(32551, "ARRAY slicing in %s", expr)
-			}
 			if i > 0 {
 				return nil, unimplemented.NewWithIssueDetailf(32552, "ind", "multidimensional indexing: %s", expr)
 			}
+			if t.Slice {
+				// Slicing an int2vector or oidvector produces a regular array.
+				expr.typ = types.MakeArray(typ.ArrayContents())
+			}
 
-			beginExpr, err := typeCheckAndRequire(ctx, semaCtx, t.Begin, types.Int, "ARRAY subscript")
-			if err != nil {
-				return nil, err
+			if t.Begin != nil {
+				beginExpr, err := typeCheckAndRequire(ctx, semaCtx, t.Begin, types.Int, "ARRAY subscript")
+				if err != nil {
+					return nil, err
+				}
+				t.Begin = beginExpr
+			}
+			if t.End != nil {
+				endExpr, err := typeCheckAndRequire(ctx, semaCtx, t.End, types.Int, "ARRAY subscript")
+				if err != nil {
+					return nil, err
+				}
+				t.End = endExpr
 			}
-			t.Begin = beginExpr
 		}
 
 		if OnTypeCheckArraySubscript != nil {
@@ -1016,6 +1031,8 @@ var (
 	errInvalidDefaultUsage = pgerror.New(pgcode.Syntax, "DEFAULT can only appear in a VALUES list within INSERT or on the right side of a SET")
 	errInvalidMaxUsage     = pgerror.New(pgcode.Syntax, "MAXVALUE can only appear within a range partition expression")
 	errInvalidMinUsage     = pgerror.New(pgcode.Syntax, "MINVALUE can only appear within a range partition expression")
+	errInvalidGroupingSet  = pgerror.New(pgcode.Syntax, "GROUPING SETS, ROLLUP and CUBE can only appear in GROUP BY")
+	errInvalidGrouping     = pgerror.New(pgcode.Grouping, "arguments to GROUPING must be grouping expressions of the associated query level")
 	errPrivateFunction     = pgerror.New(pgcode.ReservedName, "function reserved for internal use")
 )
 
@@ -1848,6 +1865,20 @@ func (expr PartitionMinVal) TypeCheck(
 	return nil, errInvalidMinUsage
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *GroupingExpr) TypeCheck(
+	_ context.Context, _ *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, errInvalidGrouping
+}
+
+// TypeCheck implements the Expr interface.
+func (expr *GroupingSet) TypeCheck(
+	_ context.Context, _ *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, errInvalidGroupingSet
+}
+
 // TypeCheck implements the Expr interface.
 func (expr PartitionMaxVal) TypeCheck(
 	_ context.Context, _ *SemaContext, desired *types.T,
@@ -15,6 +15,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
 )
 
 // showTraceNode is a planNode that processes session trace data.
@@ -174,3 +175,82 @@ var kvMsgRegexp = regexp.MustCompile(
 		"^fast path completed",
 	}, "|"),
 )
+
+// SessionTraceJSON returns the current session trace as a JSON array with one
+// object per span, in the order in which the spans first appear in
+// crdb_internal.session_trace. Each span object contains the span's index,
+// operation and duration, along with an array of its log messages. It is used
+// by the crdb_internal.session_trace_json builtin.
+func (p *planner) SessionTraceJSON() (*tree.DJSON, error) {
+	traceRows, err := p.ExtendedEvalContext().Tracing.getSessionTrace()
+	if err != nil {
+		return nil, err
+	}
+	dcc := p.SessionData().DataConversionConfig
+	loc := p.EvalContext().GetLocation()
+	type jsonField struct {
+		key string
+		col int
+	}
+	buildObject := func(r traceRow, fields []jsonField) (*json.ObjectBuilder, error) {
+		b := json.NewObjectBuilder(len(fields))
+		for _, f := range fields {
+			j, err := tree.AsJSON(r[f.col], dcc, loc)
+			if err != nil {
+				return nil, err
+			}
+			b.Add(f.key, j)
+		}
+		return b, nil
+	}
+	spanFields := []jsonField{
+		{"span_idx", traceSpanIdxCol},
+		{"operation", traceOpCol},
+		{"duration", traceDurationCol},
+	}
+	msgFields := []jsonField{
+		{"message_idx", traceMsgIdxCol},
+		{"timestamp", traceTimestampCol},
+		{"age", traceAgeCol},
+		{"loc", traceLocCol},
+		{"tag", traceTagCol},
+		{"message", traceMsgCol},
+	}
+
+	// Messages from child spans are interleaved with the messages of their
+	// parent, so group the messages by span index.
+	type spanMessages struct {
+		span     *json.ObjectBuilder
+		messages *json.ArrayBuilder
+	}
+	var spans []spanMessages
+	spanOrd := make(map[tree.DInt]int)
+	for _, r := range traceRows {
+		spanIdx := *r[traceSpanIdxCol].(*tree.DInt)
+		ord, ok := spanOrd[spanIdx]
+		if !ok {
+			span, err := buildObject(r, spanFields)
+			if err != nil {
+				return nil, err
+			}
+			ord = len(spans)
+			spanOrd[spanIdx] = ord
+			spans = append(spans, spanMessages{
+				span:     span,
+				messages: json.NewArrayBuilder(0 /* numAddsHint */),
+			})
+		}
+		msg, err := buildObject(r, msgFields)
+		if err != nil {
+			return nil, err
+		}
+		spans[ord].messages.Add(msg.Build())
+	}
+
+	res := json.NewArrayBuilder(len(spans))
+	for _, s := range spans {
+		s.span.Add("messages", s.messages.Build())
+		res.Add(s.span.Build())
+	}
+	return tree.NewDJSON(res.Build()), nil
+}
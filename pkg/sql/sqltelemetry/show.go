@@ -61,6 +61,8 @@ const (
 	ExternalConnection
 	// LogicalReplicationJobs represents the SHOW LOGICAL REPLICATION JOBS command.
 	LogicalReplicationJobs
+	// ProtectedTimestamps represents the SHOW PROTECTED TIMESTAMPS command.
+	ProtectedTimestamps
 )
 
 var showTelemetryNameMap = map[ShowTelemetryType]string{
@@ -86,6 +88,7 @@ var showTelemetryNameMap = map[ShowTelemetryType]string{
 	CreateExternalConnection: "create_external_connection",
 	ExternalConnection:       "external_connection",
 	LogicalReplicationJobs:   "logical_replication_jobs",
+	ProtectedTimestamps:      "protected_timestamps",
 }
 
 func (s ShowTelemetryType) String() string {
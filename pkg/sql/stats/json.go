@@ -8,6 +8,7 @@ package stats
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
@@ -162,6 +163,74 @@ func (js *JSONStatistic) GetHistogram(
 	return h, nil
 }
 
+// Validate checks that the statistic is internally consistent. It is used to
+// reject malformed statistics in ALTER TABLE ... INJECT STATISTICS, which
+// would otherwise silently lead to nonsensical cardinality estimates. The
+// following must hold:
+//   - the statistic is on at least one column,
+//   - null_count does not exceed row_count,
+//   - histograms are only present on single-column statistics and have a
+//     histo_col_type,
+//   - bucket counts are non-negative,
+//   - bucket upper bounds are strictly increasing.
+//
+// The distinct counts are not checked against the row counts, since they are
+// estimated and can legitimately exceed them in collected statistics.
+func (js *JSONStatistic) Validate(
+	ctx context.Context, semaCtx *tree.SemaContext, evalCtx *eval.Context,
+) error {
+	if len(js.Columns) == 0 {
+		return errors.New("statistic must have at least one column")
+	}
+	cols := strings.Join(js.Columns, ", ")
+	if js.NullCount > js.RowCount {
+		return errors.Newf(
+			"statistic on (%s) has null_count %d greater than row_count %d",
+			cols, js.NullCount, js.RowCount,
+		)
+	}
+	if js.HistogramColumnType == "" {
+		if len(js.HistogramBuckets) > 0 {
+			return errors.Newf("histogram on (%s) is missing histo_col_type", cols)
+		}
+		return nil
+	}
+	if len(js.Columns) != 1 {
+		return errors.Newf("histograms are not supported on multi-column statistic on (%s)", cols)
+	}
+	colTypeRef, err := parser.GetTypeFromValidSQLSyntax(js.HistogramColumnType)
+	if err != nil {
+		return err
+	}
+	colType, err := tree.ResolveType(ctx, colTypeRef, semaCtx.GetTypeResolver())
+	if err != nil {
+		return err
+	}
+	var prev tree.Datum
+	for i := range js.HistogramBuckets {
+		b := &js.HistogramBuckets[i]
+		if b.NumEq < 0 || b.NumRange < 0 || b.DistinctRange < 0 {
+			return errors.Newf("histogram bucket %d on (%s) has a negative count", i, cols)
+		}
+		upper, err := rowenc.ParseDatumStringAs(ctx, colType, b.UpperBound, evalCtx, semaCtx)
+		if err != nil {
+			return err
+		}
+		if prev != nil {
+			if c, err := upper.Compare(ctx, evalCtx, prev); err != nil {
+				return err
+			} else if c <= 0 {
+				return errors.Newf(
+					"histogram upper bounds on (%s) must be strictly increasing, but %s follows %s",
+					cols, b.UpperBound, js.HistogramBuckets[i-1].UpperBound,
+				)
+			}
+		}
+		prev = upper
+	}
+	return nil
+}
+
 // IsPartial returns true if this statistic was collected with a where clause.
 func (js *JSONStatistic) IsPartial() bool {
 	return js.PartialPredicate != ""
@@ -88,6 +88,10 @@ func (ex *connExecutor) execPrepareTransactionInOpenStateInternal(
 		return pgerror.Newf(pgcode.InvalidTransactionState,
 			"cannot prepare a transaction that has already performed schema changes")
 	}
+	if ex.notifications.hasPendingOps() {
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"cannot PREPARE a transaction that has executed LISTEN, UNLISTEN, or NOTIFY")
+	}
 
 	txn := ex.state.mu.txn
 	txnID := txn.ID()
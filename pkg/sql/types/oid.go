@@ -100,6 +100,7 @@ var OidToType = map[oid.Oid]*T{
 	oid.T_varbit:       VarBit,
 	oid.T_varchar:      VarChar,
 	oid.T_void:         Void,
+	oid.T_xml:          XML,
 
 	oidext.T_geometry:  Geometry,
 	oidext.T_geography: Geography,
@@ -148,6 +149,7 @@ var oidToArrayOid = map[oid.Oid]oid.Oid{
 	oid.T_uuid:         oid.T__uuid,
 	oid.T_varbit:       oid.T__varbit,
 	oid.T_varchar:      oid.T__varchar,
+	oid.T_xml:          oid.T__xml,
 
 	oidext.T_geometry:  oidext.T__geometry,
 	oidext.T_geography: oidext.T__geography,
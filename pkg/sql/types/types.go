@@ -352,6 +352,12 @@ var (
 	Name = &T{InternalType: InternalType{
 		Family: StringFamily, Oid: oid.T_name, Locale: &emptyLocale}}
 
+	// XML is a type-alias for String with a different OID (T_xml), whose
+	// values must be well-formed XML content. It is reported as XML in SHOW
+	// CREATE and "xml" in introspection for compatibility with PostgreSQL.
+	XML = &T{InternalType: InternalType{
+		Family: StringFamily, Oid: oid.T_xml, Locale: &emptyLocale}}
+
 	// Bytes is the type of a list of raw byte values.
 	Bytes = &T{InternalType: InternalType{
 		Family: BytesFamily, Oid: oid.T_bytea, Locale: &emptyLocale}}
@@ -1647,6 +1653,8 @@ func (t *T) Name() string {
 			return "varchar"
 		case oid.T_name:
 			return "name"
+		case oid.T_xml:
+			return "xml"
 		}
 		panic(errors.AssertionFailedf("unexpected OID: %d", t.Oid()))
 
@@ -1857,6 +1865,9 @@ func (t *T) SQLStandardNameWithTypmod(haveTypmod bool, typmod int) string {
 		case oid.T_name:
 			// Type modifiers not allowed for name.
 			return "name"
+		case oid.T_xml:
+			// Type modifiers not allowed for xml.
+			return "xml"
 		default:
 			panic(errors.AssertionFailedf("unexpected OID: %d", t.Oid()))
 		}
@@ -2518,7 +2529,11 @@ func (t *T) upgradeType() error {
 		case visibleQCHAR:
 			t.InternalType.Oid = oid.T_char
 		case visibleNONE:
-			t.InternalType.Oid = oid.T_text
+			// XML is the only string type other than STRING that is marshaled
+			// without a visible type, so its Oid is preserved.
+			if t.InternalType.Oid != oid.T_xml {
+				t.InternalType.Oid = oid.T_text
+			}
 		default:
 			return errors.AssertionFailedf("unexpected visible type: %d", t.InternalType.VisibleType)
 		}
@@ -2683,6 +2698,8 @@ func (t *T) downgradeType() error {
 			t.InternalType.VisibleType = visibleQCHAR
 		case oid.T_name:
 			t.InternalType.Family = name
+		case oid.T_xml:
+			// Nothing to do.
 		default:
 			return errors.AssertionFailedf("unexpected Oid: %d", t.Oid())
 		}
@@ -3003,6 +3020,8 @@ func (t *T) stringTypeSQL() string {
 		typName = `"char"`
 	case oid.T_name:
 		typName = "NAME"
+	case oid.T_xml:
+		typName = "XML"
 	}
 
 	// In general, if there is a specified width we want to print it next to the
@@ -3135,7 +3154,6 @@ var postgresPredefinedTypeIssues = map[string]int{
 	"money":         41578,
 	"path":          21286,
 	"txid_snapshot": -1,
-	"xml":           43355,
 }
 
 // SQLString outputs the GeoMetadata in a SQL-compatible string.
@@ -392,6 +392,10 @@ func TestTypes(t *testing.T) {
 			Family: StringFamily, Oid: oid.T_name, Locale: &emptyLocale}}},
 		{Name, MakeScalar(StringFamily, oid.T_name, 0, 0, emptyLocale)},
 
+		{XML, &T{InternalType: InternalType{
+			Family: StringFamily, Oid: oid.T_xml, Locale: &emptyLocale}}},
+		{XML, MakeScalar(StringFamily, oid.T_xml, 0, 0, emptyLocale)},
+
 		// TIME
 		{Time, &T{InternalType: InternalType{
 			Family: TimeFamily,
@@ -777,6 +781,7 @@ func TestMarshalCompat(t *testing.T) {
 		{MakeChar(10), InternalType{Family: StringFamily, Oid: oid.T_bpchar, Width: 10, VisibleType: visibleCHAR}},
 		{QChar, InternalType{Family: StringFamily, Oid: oid.T_char, Width: 1, VisibleType: visibleQCHAR}},
 		{Name, InternalType{Family: name, Oid: oid.T_name}},
+		{XML, InternalType{Family: StringFamily, Oid: oid.T_xml}},
 	}
 
 	for _, tc := range testCases {
@@ -1118,6 +1123,7 @@ func TestWithoutTypeModifiers(t *testing.T) {
 		{Jsonb, Jsonb},
 		{Name, Name},
 		{Uuid, Uuid},
+		{XML, XML},
 		{RefCursor, RefCursor},
 	}
 
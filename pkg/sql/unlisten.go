@@ -8,17 +8,24 @@ package sql
 import (
 	"context"
 
-	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
-	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 )
 
+// Unlisten implements the UNLISTEN statement.
+// See https://www.postgresql.org/docs/current/sql-unlisten.html for details.
 func (p *planner) Unlisten(ctx context.Context, n *tree.Unlisten) (planNode, error) {
-	p.BufferClientNotice(ctx,
-		pgerror.WithSeverity(
-			unimplemented.NewWithIssuef(41522, "CRDB does not support LISTEN, making UNLISTEN a no-op"),
-			"NOTICE",
-		),
-	)
+	notifications, err := p.notificationState()
+	if err != nil {
+		return nil, err
+	}
+	if n.Star {
+		notifications.unlistenAll()
+		return newZeroNode(nil /* columns */), nil
+	}
+	channel, err := notificationChannelName(n.ChannelName)
+	if err != nil {
+		return nil, err
+	}
+	notifications.unlisten(channel)
 	return newZeroNode(nil /* columns */), nil
 }
@@ -1473,6 +1473,50 @@ var varGen = map[string]sessionVar{
 		},
 	},
 
+	`pg_trgm.word_similarity_threshold`: {
+		GetStringVal: makeFloatGetStringValFn(`pg_trgm.word_similarity_threshold`),
+		Get: func(evalCtx *extendedEvalContext, _ *kv.Txn) (string, error) {
+			return formatFloatAsPostgresSetting(evalCtx.SessionData().TrigramWordSimilarityThreshold), nil
+		},
+		GlobalDefault: func(sv *settings.Values) string {
+			return "0.6"
+		},
+		Set: func(_ context.Context, m sessionDataMutator, s string) error {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return err
+			}
+			if f < 0 || f > 1 {
+				return pgerror.Newf(pgcode.InvalidParameterValue,
+					"%.2f is out of range for word_similarity_threshold", f)
+			}
+			m.SetTrigramWordSimilarityThreshold(f)
+			return nil
+		},
+	},
+
+	`pg_trgm.strict_word_similarity_threshold`: {
+		GetStringVal: makeFloatGetStringValFn(`pg_trgm.strict_word_similarity_threshold`),
+		Get: func(evalCtx *extendedEvalContext, _ *kv.Txn) (string, error) {
+			return formatFloatAsPostgresSetting(evalCtx.SessionData().TrigramStrictWordSimilarityThreshold), nil
+		},
+		GlobalDefault: func(sv *settings.Values) string {
+			return "0.5"
+		},
+		Set: func(_ context.Context, m sessionDataMutator, s string) error {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return err
+			}
+			if f < 0 || f > 1 {
+				return pgerror.Newf(pgcode.InvalidParameterValue,
+					"%.2f is out of range for strict_word_similarity_threshold", f)
+			}
+			m.SetTrigramStrictWordSimilarityThreshold(f)
+			return nil
+		},
+	},
+
 	// CockroachDB extension.
 	`troubleshooting_mode`: {
 		GetStringVal: makePostgresBoolGetStringValFn(`troubleshooting_mode`),
@@ -3475,6 +3519,23 @@ var varGen = map[string]sessionVar{
 		GlobalDefault: globalTrue,
 	},
 
+	// CockroachDB extension.
+	`optimizer_use_vector_search`: {
+		GetStringVal: makePostgresBoolGetStringValFn(`optimizer_use_vector_search`),
+		Set: func(_ context.Context, m sessionDataMutator, s string) error {
+			b, err := paramparse.ParseBoolVar("optimizer_use_vector_search", s)
+			if err != nil {
+				return err
+			}
+			m.SetOptimizerUseVectorSearch(b)
+			return nil
+		},
+		Get: func(evalCtx *extendedEvalContext, _ *kv.Txn) (string, error) {
+			return formatBoolAsPostgresSetting(evalCtx.SessionData().OptimizerUseVectorSearch), nil
+		},
+		GlobalDefault: globalFalse,
+	},
+
 	// CockroachDB extension.
 	`optimizer_use_improved_distinct_on_limit_hint_costing`: {
 		GetStringVal: makePostgresBoolGetStringValFn(`optimizer_use_improved_distinct_on_limit_hint_costing`),
@@ -3706,6 +3767,48 @@ var varGen = map[string]sessionVar{
 		},
 		GlobalDefault: globalFalse,
 	},
+
+	// CockroachDB extension.
+	`estimated_rows_read_err`: {
+		GetStringVal: makeIntGetStringValFn(`estimated_rows_read_err`),
+		Set: func(_ context.Context, m sessionDataMutator, s string) error {
+			i, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			if i < 0 {
+				return pgerror.Newf(pgcode.InvalidParameterValue,
+					"cannot set estimated_rows_read_err to a negative value: %d", i)
+			}
+			m.SetEstimatedRowsReadErr(i)
+			return nil
+		},
+		Get: func(evalCtx *extendedEvalContext, _ *kv.Txn) (string, error) {
+			return strconv.FormatInt(evalCtx.SessionData().EstimatedRowsReadErr, 10), nil
+		},
+		GlobalDefault: func(sv *settings.Values) string { return "0" },
+	},
+
+	// CockroachDB extension.
+	`estimated_rows_read_warn`: {
+		GetStringVal: makeIntGetStringValFn(`estimated_rows_read_warn`),
+		Set: func(_ context.Context, m sessionDataMutator, s string) error {
+			i, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			if i < 0 {
+				return pgerror.Newf(pgcode.InvalidParameterValue,
+					"cannot set estimated_rows_read_warn to a negative value: %d", i)
+			}
+			m.SetEstimatedRowsReadWarn(i)
+			return nil
+		},
+		Get: func(evalCtx *extendedEvalContext, _ *kv.Txn) (string, error) {
+			return strconv.FormatInt(evalCtx.SessionData().EstimatedRowsReadWarn, 10), nil
+		},
+		GlobalDefault: func(sv *settings.Values) string { return "0" },
+	},
 }
 
 func ReplicationModeFromString(s string) (sessiondatapb.ReplicationMode, error) {
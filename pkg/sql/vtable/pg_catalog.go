@@ -572,16 +572,19 @@ CREATE TABLE pg_catalog.pg_prepared_xacts (
 // pg_catalog.pg_prepared_statements table.
 // The statement field differs in that it uses the parsed version
 // of the PREPARE statement.
-// The parameter_types field differs from Postgres as the type names in
-// CockroachDB are slightly different.
-// https://www.postgresql.org/docs/9.6/view-pg-prepared-statements.html,
+// The parameter_types and result_types fields differ from Postgres as the type
+// names in CockroachDB are slightly different.
+// https://www.postgresql.org/docs/16/view-pg-prepared-statements.html,
 const PGCatalogPreparedStatements = `
 CREATE TABLE pg_catalog.pg_prepared_statements (
 	name TEXT,
 	statement TEXT,
 	prepare_time TIMESTAMPTZ,
 	parameter_types REGTYPE[],
-	from_sql boolean
+	result_types REGTYPE[],
+	from_sql boolean,
+	generic_plans INT8,
+	custom_plans INT8
 )`
 
 // PGCatalogProc describes the schema of the pg_catalog.pg_proc table.
@@ -1781,7 +1784,9 @@ CREATE TABLE pg_catalog.pg_statistic_ext_data (
 	stxdmcv BYTES
 )`
 
-// PgCatalogLargeobjectMetadata is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogLargeobjectMetadata describes the schema of the
+// pg_catalog.pg_largeobject_metadata table.
+// https://www.postgresql.org/docs/16/catalog-pg-largeobject-metadata.html
 const PgCatalogLargeobjectMetadata = `
 CREATE TABLE pg_catalog.pg_largeobject_metadata (
 	oid OID,
@@ -1928,7 +1933,9 @@ CREATE TABLE pg_catalog.pg_ts_dict (
 	dictinitoption STRING
 )`
 
-// PgCatalogLargeobject is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogLargeobject describes the schema of the pg_catalog.pg_largeobject
+// table.
+// https://www.postgresql.org/docs/16/catalog-pg-largeobject.html
 const PgCatalogLargeobject = `
 CREATE TABLE pg_catalog.pg_largeobject (
 	loid OID,
@@ -83,6 +83,20 @@ var upgrades = []upgradebase.Upgrade{
 		addJobsColumns,
 		upgrade.RestoreActionNotRequired("cluster restore does not restore the new field"),
 	),
+	upgrade.NewTenantUpgrade(
+		"create notifications table",
+		clusterversion.V25_1_NotificationsTable.Version(),
+		upgrade.NoPrecondition,
+		createNotificationsTable,
+		upgrade.RestoreActionNotRequired("cluster restore does not restore this table"),
+	),
+	upgrade.NewTenantUpgrade(
+		"create large_objects and large_object_metadata tables",
+		clusterversion.V25_1_LargeObjectsTable.Version(),
+		upgrade.NoPrecondition,
+		createLargeObjectsTable,
+		upgrade.RestoreActionNotRequired("table is created empty"),
+	),
 
 	// Note: when starting a new release version, the first upgrade (for
 	// Vxy_zStart) must be a newFirstUpgrade. Keep this comment at the bottom.
@@ -0,0 +1,30 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// createLargeObjectsTable creates the large_objects and
+// large_object_metadata system tables.
+func createLargeObjectsTable(
+	ctx context.Context, cv clusterversion.ClusterVersion, d upgrade.TenantDeps,
+) error {
+	if err := createSystemTable(
+		ctx, d.DB, d.Settings, d.Codec, systemschema.LargeObjectsTable, tree.LocalityLevelTable,
+	); err != nil {
+		return err
+	}
+	return createSystemTable(
+		ctx, d.DB, d.Settings, d.Codec, systemschema.LargeObjectMetadataTable, tree.LocalityLevelTable,
+	)
+}
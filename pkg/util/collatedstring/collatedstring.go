@@ -5,7 +5,10 @@
 
 package collatedstring
 
-import "golang.org/x/text/collate"
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
 
 // DefaultCollationTag is the "default" collation for strings.
 const DefaultCollationTag = "default"
@@ -19,12 +22,73 @@ const CCollationTag = "C"
 // modified.
 const PosixCollationTag = "POSIX"
 
+// Collation providers, as reported in pg_collation.collprovider.
+const (
+	// DefaultProvider is the provider of the "default" collation.
+	DefaultProvider = "d"
+	// LibcProvider is the provider of the "C" and "POSIX" collations.
+	LibcProvider = "c"
+	// ICUProvider is the provider of all other collations.
+	ICUProvider = "i"
+)
+
+// curatedCollations are collations that are not tied to a language and are
+// commonly defined in Postgres with CREATE COLLATION ... (provider = icu).
+// Since the root locale orders strings in a language-neutral way, they are
+// the recommended way to get case- or accent-insensitive comparisons.
+var curatedCollations = []string{
+	// Case-insensitive: 'a' = 'A'.
+	"und-u-ks-level2",
+	// Case- and accent-insensitive: 'a' = 'A' = 'á'.
+	"und-u-ks-level1",
+	// Accent-insensitive, case-sensitive: 'a' = 'á', 'a' <> 'A'.
+	"und-u-ks-level1-kc-true",
+}
+
 var supportedTagNames []string
 
+// IsDefaultEquivalentCollation returns whether the collation behaves the same
+// as the "default" collation.
 func IsDefaultEquivalentCollation(s string) bool {
 	return s == DefaultCollationTag || s == CCollationTag || s == PosixCollationTag
 }
 
+// Provider returns the provider of the given collation.
+func Provider(s string) string {
+	switch s {
+	case DefaultCollationTag:
+		return DefaultProvider
+	case CCollationTag, PosixCollationTag:
+		return LibcProvider
+	default:
+		return ICUProvider
+	}
+}
+
+// IsDeterministic returns whether the collation only considers strings equal
+// if they consist of the same characters. Collations that compare strings at
+// the primary or secondary strength (ks-level1, ks-level2), or that ignore
+// punctuation (ka-shifted), are nondeterministic: for example, 'a' and 'A'
+// are equal under "und-u-ks-level2".
+//
+// Values of a nondeterministic collation that compare equal have the same key
+// encoding, so they conflict in unique indexes and are grouped together by
+// DISTINCT and GROUP BY, as in Postgres.
+func IsDeterministic(s string) bool {
+	if IsDefaultEquivalentCollation(s) {
+		return true
+	}
+	tag, err := language.Parse(s)
+	if err != nil {
+		return true
+	}
+	switch tag.TypeForKey("ks") {
+	case "level1", "level2":
+		return false
+	}
+	return tag.TypeForKey("ka") != "shifted"
+}
+
 // Supported returns a list of all the collation names that are supported.
 func Supported() []string {
 	return supportedTagNames
@@ -44,4 +108,5 @@ func init() {
 	for _, t := range collate.Supported() {
 		supportedTagNames = append(supportedTagNames, t.String())
 	}
+	supportedTagNames = append(supportedTagNames, curatedCollations...)
 }
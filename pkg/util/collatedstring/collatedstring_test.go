@@ -0,0 +1,48 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package collatedstring
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDeterministic(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		locale        string
+		deterministic bool
+	}{
+		{DefaultCollationTag, true},
+		{CCollationTag, true},
+		{"en", true},
+		{"en-US", true},
+		{"en-u-ks-level3", true},
+		{"und-u-ks-level2", false},
+		{"en_US_u_ks_level2", false},
+		{"und-u-ks-level1-kc-true", false},
+		{"fr-u-ka-shifted", false},
+	} {
+		t.Run(tc.locale, func(t *testing.T) {
+			require.Equal(t, tc.deterministic, IsDeterministic(tc.locale))
+		})
+	}
+}
+
+func TestSupported(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	supported := Supported()
+	for _, c := range curatedCollations {
+		require.Contains(t, supported, c)
+	}
+	require.Equal(t, DefaultProvider, Provider(DefaultCollationTag))
+	require.Equal(t, LibcProvider, Provider(PosixCollationTag))
+	require.Equal(t, ICUProvider, Provider("und-u-ks-level2"))
+}
@@ -878,6 +878,67 @@ func (d Duration) DivFloat(x float64) Duration {
 	)
 }
 
+// JustifyHours returns a Duration where each 24 hours are moved into days, so
+// that the time part is less than a day and has the same sign as the days.
+// This matches Postgres' justify_hours.
+func (d Duration) JustifyHours() Duration {
+	wholeDays := d.nanos / nanosInDay
+	d.Days += wholeDays
+	d.nanos -= wholeDays * nanosInDay
+	if d.Days > 0 && d.nanos < 0 {
+		d.nanos += nanosInDay
+		d.Days--
+	} else if d.Days < 0 && d.nanos > 0 {
+		d.nanos -= nanosInDay
+		d.Days++
+	}
+	return d
+}
+
+// JustifyDays returns a Duration where each 30 days are moved into months, so
+// that the days are fewer than 30 and have the same sign as the months. This
+// matches Postgres' justify_days.
+func (d Duration) JustifyDays() Duration {
+	wholeMonths := d.Days / DaysPerMonth
+	d.Months += wholeMonths
+	d.Days -= wholeMonths * DaysPerMonth
+	if d.Months > 0 && d.Days < 0 {
+		d.Days += DaysPerMonth
+		d.Months--
+	} else if d.Months < 0 && d.Days > 0 {
+		d.Days -= DaysPerMonth
+		d.Months++
+	}
+	return d
+}
+
+// JustifyInterval returns a Duration where both hours and days are justified
+// as in JustifyHours and JustifyDays, with all three parts having the same
+// sign. This matches Postgres' justify_interval.
+func (d Duration) JustifyInterval() Duration {
+	wholeDays := d.nanos / nanosInDay
+	d.Days += wholeDays
+	d.nanos -= wholeDays * nanosInDay
+	wholeMonths := d.Days / DaysPerMonth
+	d.Months += wholeMonths
+	d.Days -= wholeMonths * DaysPerMonth
+	if d.Months > 0 && (d.Days < 0 || (d.Days == 0 && d.nanos < 0)) {
+		d.Days += DaysPerMonth
+		d.Months--
+	} else if d.Months < 0 && (d.Days > 0 || (d.Days == 0 && d.nanos > 0)) {
+		d.Days -= DaysPerMonth
+		d.Months++
+	}
+	if d.Days > 0 && d.nanos < 0 {
+		d.nanos += nanosInDay
+		d.Days--
+	} else if d.Days < 0 && d.nanos > 0 {
+		d.nanos -= nanosInDay
+		d.Days++
+	}
+	return d
+}
+
 // secRoundToEven rounds the given float to the nearest second,
 // assuming the input float is a microsecond representation of
 // time
@@ -578,6 +578,65 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestJustify(t *testing.T) {
+	const hour = int64(time.Hour)
+	testCases := []struct {
+		d                         Duration
+		justifyHours, justifyDays Duration
+		justifyInterval           Duration
+	}{
+		{
+			d:               MakeDuration(27*hour, 0, 0),
+			justifyHours:    MakeDuration(3*hour, 1, 0),
+			justifyDays:     MakeDuration(27*hour, 0, 0),
+			justifyInterval: MakeDuration(3*hour, 1, 0),
+		},
+		{
+			d:               MakeDuration(0, 35, 0),
+			justifyHours:    MakeDuration(0, 35, 0),
+			justifyDays:     MakeDuration(0, 5, 1),
+			justifyInterval: MakeDuration(0, 5, 1),
+		},
+		{
+			d:               MakeDuration(-hour, 1, 0),
+			justifyHours:    MakeDuration(23*hour, 0, 0),
+			justifyDays:     MakeDuration(-hour, 1, 0),
+			justifyInterval: MakeDuration(23*hour, 0, 0),
+		},
+		{
+			d:               MakeDuration(-hour, 0, 1),
+			justifyHours:    MakeDuration(-hour, 0, 1),
+			justifyDays:     MakeDuration(-hour, 0, 1),
+			justifyInterval: MakeDuration(23*hour, 29, 0),
+		},
+		{
+			d:               MakeDuration(hour, 0, -1),
+			justifyHours:    MakeDuration(hour, 0, -1),
+			justifyDays:     MakeDuration(hour, 0, -1),
+			justifyInterval: MakeDuration(-23*hour, -29, 0),
+		},
+		{
+			d:               MakeDuration(0, -35, 1),
+			justifyHours:    MakeDuration(0, -35, 1),
+			justifyDays:     MakeDuration(0, -5, 0),
+			justifyInterval: MakeDuration(0, -5, 0),
+		},
+		{
+			d:               MakeDuration(-50*hour, 62, -1),
+			justifyHours:    MakeDuration(22*hour, 59, -1),
+			justifyDays:     MakeDuration(-50*hour, 2, 1),
+			justifyInterval: MakeDuration(22*hour, 29, 0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			require.Equal(t, tc.justifyHours, tc.d.JustifyHours())
+			require.Equal(t, tc.justifyDays, tc.d.JustifyDays())
+			require.Equal(t, tc.justifyInterval, tc.d.JustifyInterval())
+		})
+	}
+}
+
 // TestNanos verifies that nanoseconds can only be present after Decode and
 // that any operation will remove them.
 func TestNanos(t *testing.T) {
@@ -0,0 +1,751 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package jsonpath
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+	"github.com/cockroachdb/errors"
+)
+
+// errSuppressible marks the errors that are suppressed in silent mode and
+// when evaluating the operands of predicates.
+var errSuppressible = errors.New("suppressible jsonpath error")
+
+// errorf returns an evaluation error that is suppressed in silent mode.
+func errorf(code pgcode.Code, format string, args ...interface{}) error {
+	return errors.Mark(pgerror.Newf(code, format, args...), errSuppressible)
+}
+
+var (
+	// exactCtx is used for addition, subtraction, multiplication and modulo.
+	exactCtx = &apd.Context{
+		Precision:   2000,
+		Rounding:    apd.RoundHalfUp,
+		MaxExponent: 2000,
+		MinExponent: -2000,
+		Traps:       apd.DefaultTraps,
+	}
+	// divCtx is used for division. It matches the precision of decimal
+	// division in SQL.
+	divCtx = exactCtx.WithPrecision(20)
+	// truncCtx is used to truncate array subscripts to integers.
+	truncCtx = &apd.Context{
+		Precision:   2000,
+		Rounding:    apd.RoundDown,
+		MaxExponent: 2000,
+		MinExponent: -2000,
+		Traps:       apd.DefaultTraps,
+	}
+)
+
+// Query evaluates the path against target and returns the resulting items.
+// vars is an object containing the values of the named variables in the
+// path, and may be nil. If silent is true, errors caused by missing keys,
+// type mismatches and arithmetic are suppressed and the result is empty.
+func Query(path *Path, target, vars json.JSON, silent bool) ([]json.JSON, error) {
+	if vars != nil && vars.Type() != json.ObjectJSONType {
+		return nil, errors.WithDetail(
+			pgerror.New(pgcode.InvalidParameterValue, `"vars" argument is not an object`),
+			`Jsonpath parameters should be encoded as key-value pairs of "vars" object.`,
+		)
+	}
+	e := evaluator{strict: path.Strict, root: target, vars: vars}
+	res, err := e.eval(path.expr, target, -1 /* last */)
+	if err != nil {
+		if silent && errors.Is(err, errSuppressible) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exists returns whether evaluating the path against target produces any
+// items. ok is false if an error was suppressed because silent is true.
+func Exists(path *Path, target, vars json.JSON, silent bool) (exists, ok bool, err error) {
+	res, err := Query(path, target, vars, false /* silent */)
+	if err != nil {
+		if silent && errors.Is(err, errSuppressible) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+	return len(res) > 0, true, nil
+}
+
+// Match returns the result of a path that is a predicate, such as
+// `$.a > 1`. ok is false if the result is unknown, or if an error was
+// suppressed because silent is true.
+func Match(path *Path, target, vars json.JSON, silent bool) (match, ok bool, err error) {
+	res, err := Query(path, target, vars, false /* silent */)
+	if err == nil {
+		if len(res) == 1 {
+			switch res[0].Type() {
+			case json.TrueJSONType:
+				return true, true, nil
+			case json.FalseJSONType:
+				return false, true, nil
+			case json.NullJSONType:
+				return false, false, nil
+			}
+		}
+		err = errorf(pgcode.SingletonSQLJSONItemRequired, "single boolean result is expected")
+	}
+	if silent && errors.Is(err, errSuppressible) {
+		return false, false, nil
+	}
+	return false, false, err
+}
+
+// tri is the result of a predicate, which may be unknown.
+type tri int
+
+const (
+	triFalse tri = iota
+	triTrue
+	triUnknown
+)
+
+func makeTri(b bool) tri {
+	if b {
+		return triTrue
+	}
+	return triFalse
+}
+
+type evaluator struct {
+	strict bool
+	// ignoreStructural is set while evaluating the accessors following .**,
+	// which are applied to items of every type, so that strict mode does not
+	// report the resulting structural errors.
+	ignoreStructural bool
+	root             json.JSON
+	vars             json.JSON
+}
+
+// structuralErrors returns whether accessors applied to items of the wrong
+// type, missing keys and out of bounds subscripts are errors.
+func (e *evaluator) structuralErrors() bool {
+	return e.strict && !e.ignoreStructural
+}
+
+// eval evaluates the expression with the given current item, which is the
+// value of @, and the index of the last element of the innermost array being
+// subscripted.
+func (e *evaluator) eval(n node, cur json.JSON, last int) ([]json.JSON, error) {
+	switch n := n.(type) {
+	case rootNode:
+		return []json.JSON{e.root}, nil
+	case currentNode:
+		return []json.JSON{cur}, nil
+	case lastNode:
+		return []json.JSON{json.FromInt(last)}, nil
+	case literalNode:
+		return []json.JSON{n.val}, nil
+	case varNode:
+		var v json.JSON
+		if e.vars != nil {
+			var err error
+			if v, err = e.vars.FetchValKey(n.name); err != nil {
+				return nil, err
+			}
+		}
+		if v == nil {
+			return nil, pgerror.Newf(pgcode.UndefinedObject, "could not find jsonpath variable %q", n.name)
+		}
+		return []json.JSON{v}, nil
+	case chainNode:
+		items, err := e.eval(n.base, cur, last)
+		if err != nil {
+			return nil, err
+		}
+		defer func(saved bool) { e.ignoreStructural = saved }(e.ignoreStructural)
+		for _, s := range n.steps {
+			if items, err = e.applyStep(s, items, cur); err != nil {
+				return nil, err
+			}
+			if _, ok := s.(recursiveStep); ok {
+				e.ignoreStructural = true
+			}
+		}
+		return items, nil
+	case binaryNode:
+		if n.op <= opMod {
+			return e.evalArithmetic(n, cur, last)
+		}
+	case unaryNode:
+		if n.op != '!' {
+			items, err := e.evalUnwrapped(n.operand, cur, last)
+			if err != nil {
+				return nil, err
+			}
+			for i, item := range items {
+				d, ok := item.AsDecimal()
+				if !ok {
+					return nil, errorf(pgcode.NonNumericSQLJSONItem,
+						"operand of unary jsonpath operator %s is not a numeric value", string(n.op))
+				}
+				if n.op == '-' {
+					var neg apd.Decimal
+					neg.Neg(d)
+					items[i] = json.FromDecimal(neg)
+				}
+			}
+			return items, nil
+		}
+	}
+	// The node is a predicate, which evaluates to a boolean, or to null if its
+	// result is unknown.
+	res, err := e.evalPredicate(n, cur, last)
+	if err != nil {
+		return nil, err
+	}
+	switch res {
+	case triTrue:
+		return []json.JSON{json.TrueJSONValue}, nil
+	case triFalse:
+		return []json.JSON{json.FalseJSONValue}, nil
+	default:
+		return []json.JSON{json.NullJSONValue}, nil
+	}
+}
+
+// evalUnwrapped evaluates the expression and, in lax mode, replaces each
+// array in the result with its elements.
+func (e *evaluator) evalUnwrapped(n node, cur json.JSON, last int) ([]json.JSON, error) {
+	items, err := e.eval(n, cur, last)
+	if err != nil || e.strict {
+		return items, err
+	}
+	return unwrapArrays(items)
+}
+
+func unwrapArrays(items []json.JSON) ([]json.JSON, error) {
+	var res []json.JSON
+	for _, item := range items {
+		if item.Type() != json.ArrayJSONType {
+			res = append(res, item)
+			continue
+		}
+		for i := 0; i < item.Len(); i++ {
+			elem, err := item.FetchValIdx(i)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, elem)
+		}
+	}
+	return res, nil
+}
+
+func (e *evaluator) evalArithmetic(n binaryNode, cur json.JSON, last int) ([]json.JSON, error) {
+	operand := func(side string, operand node) (*apd.Decimal, error) {
+		items, err := e.evalUnwrapped(operand, cur, last)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 1 {
+			if d, ok := items[0].AsDecimal(); ok {
+				return d, nil
+			}
+		}
+		return nil, errorf(pgcode.SingletonSQLJSONItemRequired,
+			"%s operand of jsonpath operator %s is not a single numeric value", side, n.op)
+	}
+	l, err := operand("left", n.left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := operand("right", n.right)
+	if err != nil {
+		return nil, err
+	}
+	var res apd.Decimal
+	switch n.op {
+	case opAdd:
+		_, err = exactCtx.Add(&res, l, r)
+	case opSub:
+		_, err = exactCtx.Sub(&res, l, r)
+	case opMul:
+		_, err = exactCtx.Mul(&res, l, r)
+	case opDiv, opMod:
+		if r.IsZero() {
+			return nil, errorf(pgcode.DivisionByZero, "division by zero")
+		}
+		if n.op == opDiv {
+			_, err = divCtx.Quo(&res, l, r)
+		} else {
+			_, err = exactCtx.Rem(&res, l, r)
+		}
+	}
+	if err != nil {
+		return nil, errorf(pgcode.NumericValueOutOfRange, "numeric value out of range")
+	}
+	return []json.JSON{json.FromDecimal(res)}, nil
+}
+
+func (e *evaluator) applyStep(s step, items []json.JSON, cur json.JSON) ([]json.JSON, error) {
+	var res []json.JSON
+	for _, item := range items {
+		var err error
+		if res, err = e.applyStepToItem(s, item, cur, res, true /* unwrap */); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// applyStepToItem applies the accessor to the item, appending the results to
+// res. If unwrap is true and the path is in lax mode, accessors that don't
+// apply to arrays are applied to each element of an array item instead.
+func (e *evaluator) applyStepToItem(
+	s step, item, cur json.JSON, res []json.JSON, unwrap bool,
+) ([]json.JSON, error) {
+	isArray := item.Type() == json.ArrayJSONType
+	unwrapElements := func() ([]json.JSON, error) {
+		for i := 0; i < item.Len(); i++ {
+			elem, err := item.FetchValIdx(i)
+			if err != nil {
+				return nil, err
+			}
+			if res, err = e.applyStepToItem(s, elem, cur, res, false /* unwrap */); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+
+	switch s := s.(type) {
+	case keyStep:
+		if item.Type() == json.ObjectJSONType {
+			v, err := item.FetchValKey(s.key)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				if e.structuralErrors() {
+					return nil, errorf(pgcode.SQLJSONMemberNotFound,
+						"JSON object does not contain key %q", s.key)
+				}
+				return res, nil
+			}
+			return append(res, v), nil
+		}
+		if isArray && unwrap && !e.strict {
+			return unwrapElements()
+		}
+		if e.structuralErrors() {
+			return nil, errorf(pgcode.SQLJSONObjectNotFound,
+				"jsonpath member accessor can only be applied to an object")
+		}
+		return res, nil
+
+	case anyKeyStep:
+		if item.Type() == json.ObjectJSONType {
+			values, err := objectValues(item)
+			return append(res, values...), err
+		}
+		if isArray && unwrap && !e.strict {
+			return unwrapElements()
+		}
+		if e.structuralErrors() {
+			return nil, errorf(pgcode.SQLJSONObjectNotFound,
+				"jsonpath wildcard member accessor can only be applied to an object")
+		}
+		return res, nil
+
+	case anyIndexStep:
+		if isArray {
+			elems, err := unwrapArrays([]json.JSON{item})
+			return append(res, elems...), err
+		}
+		if e.structuralErrors() {
+			return nil, errorf(pgcode.SQLJSONArrayNotFound,
+				"jsonpath wildcard array accessor can only be applied to an array")
+		}
+		return append(res, item), nil
+
+	case indexStep:
+		elems := []json.JSON{item}
+		if isArray {
+			var err error
+			if elems, err = unwrapArrays(elems); err != nil {
+				return nil, err
+			}
+		} else if e.structuralErrors() {
+			return nil, errorf(pgcode.SQLJSONArrayNotFound,
+				"jsonpath array accessor can only be applied to an array")
+		}
+		last := len(elems) - 1
+		for _, sub := range s.subscripts {
+			from, err := e.evalSubscript(sub.from, cur, last)
+			if err != nil {
+				return nil, err
+			}
+			to := from
+			if sub.to != nil {
+				if to, err = e.evalSubscript(sub.to, cur, last); err != nil {
+					return nil, err
+				}
+			}
+			if e.structuralErrors() && (from < 0 || from > to || to > int64(last)) {
+				return nil, errorf(pgcode.InvalidSQLJSONSubscript, "jsonpath array subscript is out of bounds")
+			}
+			from = max(from, 0)
+			to = min(to, int64(last))
+			for i := from; i <= to; i++ {
+				res = append(res, elems[i])
+			}
+		}
+		return res, nil
+
+	case recursiveStep:
+		res = append(res, item)
+		var children []json.JSON
+		var err error
+		switch item.Type() {
+		case json.ArrayJSONType:
+			children, err = unwrapArrays([]json.JSON{item})
+		case json.ObjectJSONType:
+			children, err = objectValues(item)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range children {
+			if res, err = e.applyStepToItem(s, c, cur, res, false /* unwrap */); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+
+	case filterStep:
+		if isArray && unwrap && !e.strict {
+			return unwrapElements()
+		}
+		r, err := e.evalPredicate(s.pred, item, -1 /* last */)
+		if err != nil {
+			return nil, err
+		}
+		if r == triTrue {
+			res = append(res, item)
+		}
+		return res, nil
+
+	case methodStep:
+		switch s.name {
+		case "type":
+			return append(res, json.FromString(typeName(item))), nil
+		case "size":
+			if isArray {
+				return append(res, json.FromInt(item.Len())), nil
+			}
+			if e.structuralErrors() {
+				return nil, errorf(pgcode.SQLJSONArrayNotFound,
+					"jsonpath item method .size() can only be applied to an array")
+			}
+			return append(res, json.FromInt(1)), nil
+		}
+		if isArray && unwrap && !e.strict {
+			return unwrapElements()
+		}
+		v, err := applyNumericMethod(s.name, item)
+		if err != nil {
+			return nil, err
+		}
+		return append(res, v), nil
+	}
+	return nil, errors.AssertionFailedf("unexpected jsonpath step %T", s)
+}
+
+// evalSubscript evaluates an array subscript, which must be a single number.
+// It is truncated to an integer.
+func (e *evaluator) evalSubscript(n node, cur json.JSON, last int) (int64, error) {
+	items, err := e.eval(n, cur, last)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 1 {
+		if d, ok := items[0].AsDecimal(); ok {
+			var truncated apd.Decimal
+			if _, err := truncCtx.RoundToIntegralValue(&truncated, d); err == nil {
+				if i, err := truncated.Int64(); err == nil && i >= math.MinInt32 && i <= math.MaxInt32 {
+					return i, nil
+				}
+			}
+			return 0, errorf(pgcode.InvalidSQLJSONSubscript, "jsonpath array subscript is out of integer range")
+		}
+	}
+	return 0, errorf(pgcode.InvalidSQLJSONSubscript, "jsonpath array subscript is not a single numeric value")
+}
+
+func objectValues(j json.JSON) ([]json.JSON, error) {
+	it, err := j.ObjectIter()
+	if err != nil {
+		return nil, err
+	}
+	var res []json.JSON
+	for it.Next() {
+		res = append(res, it.Value())
+	}
+	return res, nil
+}
+
+func typeName(j json.JSON) string {
+	switch j.Type() {
+	case json.NullJSONType:
+		return "null"
+	case json.TrueJSONType, json.FalseJSONType:
+		return "boolean"
+	case json.NumberJSONType:
+		return "number"
+	case json.StringJSONType:
+		return "string"
+	case json.ArrayJSONType:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func applyNumericMethod(name string, item json.JSON) (json.JSON, error) {
+	if name == "double" {
+		var f float64
+		switch item.Type() {
+		case json.NumberJSONType:
+			d, _ := item.AsDecimal()
+			var err error
+			if f, err = d.Float64(); err != nil {
+				return nil, errorf(pgcode.NonNumericSQLJSONItem,
+					"numeric argument of jsonpath item method .double() is out of range for type double precision")
+			}
+		case json.StringJSONType:
+			s, err := item.AsText()
+			if err != nil {
+				return nil, err
+			}
+			if f, err = strconv.ParseFloat(strings.TrimSpace(*s), 64); err != nil {
+				return nil, errorf(pgcode.NonNumericSQLJSONItem,
+					"string argument of jsonpath item method .double() is not a valid representation of a double precision number")
+			}
+		default:
+			return nil, errorf(pgcode.NonNumericSQLJSONItem,
+				"jsonpath item method .double() can only be applied to a string or numeric value")
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, errorf(pgcode.NonNumericSQLJSONItem,
+				"NaN or Infinity is not allowed for jsonpath item method .double()")
+		}
+		return json.FromFloat64(f)
+	}
+
+	d, ok := item.AsDecimal()
+	if !ok {
+		return nil, errorf(pgcode.NonNumericSQLJSONItem,
+			"jsonpath item method .%s() can only be applied to a numeric value", name)
+	}
+	var res apd.Decimal
+	var err error
+	switch name {
+	case "abs":
+		res.Abs(d)
+	case "ceiling":
+		_, err = exactCtx.Ceil(&res, d)
+	case "floor":
+		_, err = exactCtx.Floor(&res, d)
+	default:
+		return nil, errors.AssertionFailedf("unexpected jsonpath item method %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.FromDecimal(res), nil
+}
+
+// evalPredicate evaluates a predicate with three-valued logic. Suppressible
+// errors in the evaluation of its operands make the result unknown.
+func (e *evaluator) evalPredicate(n node, cur json.JSON, last int) (tri, error) {
+	switch n := n.(type) {
+	case binaryNode:
+		switch n.op {
+		case opAnd, opOr:
+			l, err := e.evalPredicate(n.left, cur, last)
+			if err != nil {
+				return 0, err
+			}
+			if (n.op == opAnd && l == triFalse) || (n.op == opOr && l == triTrue) {
+				return l, nil
+			}
+			r, err := e.evalPredicate(n.right, cur, last)
+			if err != nil {
+				return 0, err
+			}
+			if (n.op == opAnd && r == triFalse) || (n.op == opOr && r == triTrue) {
+				return r, nil
+			}
+			if l == triUnknown || r == triUnknown {
+				return triUnknown, nil
+			}
+			return l, nil
+		}
+		return e.evalComparison(n, cur, last)
+
+	case unaryNode:
+		r, err := e.evalPredicate(n.operand, cur, last)
+		if err != nil || r == triUnknown {
+			return r, err
+		}
+		return makeTri(r == triFalse), nil
+
+	case isUnknownNode:
+		r, err := e.evalPredicate(n.pred, cur, last)
+		return makeTri(r == triUnknown), err
+
+	case existsNode:
+		items, err := e.eval(n.expr, cur, last)
+		if err != nil {
+			return unknownIfSuppressible(err)
+		}
+		return makeTri(len(items) > 0), nil
+
+	case likeRegexNode:
+		items, err := e.evalUnwrapped(n.expr, cur, last)
+		if err != nil {
+			return unknownIfSuppressible(err)
+		}
+		results := make([]tri, len(items))
+		for i, item := range items {
+			results[i] = triUnknown
+			if item.Type() == json.StringJSONType {
+				s, _ := item.AsText()
+				results[i] = makeTri(n.re.MatchString(*s))
+			}
+		}
+		return e.combine(results), nil
+	}
+	return 0, errors.AssertionFailedf("unexpected jsonpath predicate %T", n)
+}
+
+func unknownIfSuppressible(err error) (tri, error) {
+	if errors.Is(err, errSuppressible) {
+		return triUnknown, nil
+	}
+	return 0, err
+}
+
+// evalComparison evaluates a comparison or starts with predicate over each
+// pair of items from its operands.
+func (e *evaluator) evalComparison(n binaryNode, cur json.JSON, last int) (tri, error) {
+	l, err := e.evalUnwrapped(n.left, cur, last)
+	if err != nil {
+		return unknownIfSuppressible(err)
+	}
+	var r []json.JSON
+	if n.op == opStartsWith {
+		r, err = e.eval(n.right, cur, last)
+	} else {
+		r, err = e.evalUnwrapped(n.right, cur, last)
+	}
+	if err != nil {
+		return unknownIfSuppressible(err)
+	}
+	results := make([]tri, 0, len(l)*len(r))
+	for _, li := range l {
+		for _, ri := range r {
+			if n.op == opStartsWith {
+				results = append(results, startsWith(li, ri))
+			} else {
+				results = append(results, compareItems(n.op, li, ri))
+			}
+		}
+	}
+	return e.combine(results), nil
+}
+
+// combine returns the result of a predicate evaluated over several items,
+// which is true if it is true for any item. In strict mode, it is unknown if
+// it is unknown for any item; in lax mode, this is only the case when it is
+// not true for any item.
+func (e *evaluator) combine(results []tri) tri {
+	found, unknown := false, false
+	for _, r := range results {
+		found = found || r == triTrue
+		unknown = unknown || r == triUnknown
+	}
+	if unknown && (e.strict || !found) {
+		return triUnknown
+	}
+	return makeTri(found)
+}
+
+func startsWith(l, r json.JSON) tri {
+	if l.Type() != json.StringJSONType || r.Type() != json.StringJSONType {
+		return triUnknown
+	}
+	ls, _ := l.AsText()
+	rs, _ := r.AsText()
+	return makeTri(strings.HasPrefix(*ls, *rs))
+}
+
+func isBool(j json.JSON) bool {
+	t := j.Type()
+	return t == json.TrueJSONType || t == json.FalseJSONType
+}
+
+// compareItems compares two items. Items of different types are not
+// comparable, except that null is not equal to any other value.
+func compareItems(op binaryOp, l, r json.JSON) tri {
+	lt, rt := l.Type(), r.Type()
+	if lt != rt && !(isBool(l) && isBool(r)) {
+		if lt == json.NullJSONType || rt == json.NullJSONType {
+			return makeTri(op == opNe)
+		}
+		return triUnknown
+	}
+	var cmp int
+	switch lt {
+	case json.NullJSONType:
+	case json.TrueJSONType, json.FalseJSONType:
+		lb, rb := lt == json.TrueJSONType, rt == json.TrueJSONType
+		if lb != rb {
+			cmp = -1
+			if lb {
+				cmp = 1
+			}
+		}
+	case json.NumberJSONType:
+		ld, _ := l.AsDecimal()
+		rd, _ := r.AsDecimal()
+		cmp = ld.Cmp(rd)
+	case json.StringJSONType:
+		ls, _ := l.AsText()
+		rs, _ := r.AsText()
+		cmp = strings.Compare(*ls, *rs)
+	default:
+		return triUnknown
+	}
+	switch op {
+	case opEq:
+		return makeTri(cmp == 0)
+	case opNe:
+		return makeTri(cmp != 0)
+	case opLt:
+		return makeTri(cmp < 0)
+	case opLe:
+		return makeTri(cmp <= 0)
+	case opGt:
+		return makeTri(cmp > 0)
+	default:
+		return makeTri(cmp >= 0)
+	}
+}
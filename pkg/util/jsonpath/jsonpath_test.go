@@ -0,0 +1,201 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package jsonpath
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		expected string
+	}{
+		{``, `syntax error at end of jsonpath input`},
+		{`$.`, `syntax error at end of jsonpath input`},
+		{`$.a ==`, `syntax error at end of jsonpath input`},
+		{`$ ? (@.a)`, `syntax error at or near ")" of jsonpath input`},
+		{`@.a`, `@ is not allowed in root expressions`},
+		{`last`, `LAST is allowed only in array subscripts`},
+		{`$.a.foo()`, `unsupported jsonpath item method .foo()`},
+		{`1a`, `trailing junk after numeric literal at or near "1a" of jsonpath input`},
+		{`$ ? (@ like_regex "a" flag "x")`, `unrecognized flag character "x" in LIKE_REGEX predicate`},
+		{`$."a`, `unterminated quoted string in jsonpath input`},
+		{`$ # 1`, `syntax error at or near "#" of jsonpath input`},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			_, err := Parse(tc.path)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expected)
+			require.Equal(t, pgcode.Syntax, pgerror.GetPGCode(err))
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	const doc = `{
+		"a": 1,
+		"b": [1, 2, 3, 4],
+		"c": {"d": "foo", "e": [{"f": 1}, {"f": 2}]},
+		"g": null,
+		"h": "2.5"
+	}`
+	target, err := json.ParseJSON(doc)
+	require.NoError(t, err)
+	vars, err := json.ParseJSON(`{"x": 2, "s": "fo"}`)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		path     string
+		expected string
+	}{
+		{`$`, `[` + target.String() + `]`},
+		{`$.a`, `[1]`},
+		{`$."a"`, `[1]`},
+		{`$.missing`, `[]`},
+		{`$.b[0]`, `[1]`},
+		{`$.b[last]`, `[4]`},
+		{`$.b[1 to last - 1]`, `[2, 3]`},
+		{`$.b[0, 2 to 3]`, `[1, 3, 4]`},
+		{`$.b[10]`, `[]`},
+		{`$.b[1.9]`, `[2]`},
+		{`$.b[*]`, `[1, 2, 3, 4]`},
+		{`$.a[0]`, `[1]`},
+		{`$.c.*`, `["foo", [{"f": 1}, {"f": 2}]]`},
+		{`$.c.e.f`, `[1, 2]`},
+		{`$.c.e[*].f`, `[1, 2]`},
+		{`$.**.f`, `[1, 2, 1, 2]`},
+		{`strict $.**.f`, `[1, 2]`},
+		{`$.b ? (@ > 2)`, `[3, 4]`},
+		{`$.b[*] ? (@ > $x)`, `[3, 4]`},
+		{`$.b ? (@ > 1 && @ < 4)`, `[2, 3]`},
+		{`$.b ? (@ == 1 || @ == 4)`, `[1, 4]`},
+		{`$.b ? (!(@ == 1))`, `[2, 3, 4]`},
+		{`$.c.e ? (exists (@.f ? (@ == 2)))`, `[{"f": 2}]`},
+		{`$.c.d ? (@ starts with "fo")`, `["foo"]`},
+		{`$.c.d ? (@ starts with $s)`, `["foo"]`},
+		{`$.c.d ? (@ like_regex "^F" flag "i")`, `["foo"]`},
+		{`$.c.d ? (@ like_regex "^F")`, `[]`},
+		{`$ ? ((@.a == "x") is unknown).a`, `[1]`},
+		{`$.a + 1`, `[2]`},
+		{`$.a - $x * 3`, `[-5]`},
+		{`-$.b`, `[-1, -2, -3, -4]`},
+		{`7 % 4`, `[3]`},
+		{`$.b.size()`, `[4]`},
+		{`$.a.size()`, `[1]`},
+		{`$.b.type()`, `["array"]`},
+		{`$.*.type()`, `["number", "array", "object", "null", "string"]`},
+		{`$.h.double()`, `[2.5]`},
+		{`$.h.double().floor()`, `[2]`},
+		{`$.h.double().ceiling()`, `[3]`},
+		{`(-$.a).abs()`, `[1]`},
+		{`$.a == 1`, `[true]`},
+		{`$.a == "1"`, `[null]`},
+		{`$.g == 1`, `[false]`},
+		{`$.g != 1`, `[true]`},
+		{`$.b[*] > 3`, `[true]`},
+		{`$.missing == 1`, `[false]`},
+		{`strict $.a == "1"`, `[null]`},
+		{`$.c.d.e`, `[]`},
+		{`$.a / 0`, `division by zero`},
+		{`$.b + 1`, `left operand of jsonpath operator + is not a single numeric value`},
+		{`$.c.d.double()`, `string argument of jsonpath item method .double() is not a valid representation of a double precision number`},
+		{`strict $.missing`, `JSON object does not contain key "missing"`},
+		{`strict $.b.a`, `jsonpath member accessor can only be applied to an object`},
+		{`strict $.a[0]`, `jsonpath array accessor can only be applied to an array`},
+		{`strict $.b[4]`, `jsonpath array subscript is out of bounds`},
+		{`strict $.a.size()`, `jsonpath item method .size() can only be applied to an array`},
+		{`$.b["a"]`, `jsonpath array subscript is not a single numeric value`},
+		{`$.b[1e20]`, `jsonpath array subscript is out of integer range`},
+		{`$undefined`, `could not find jsonpath variable "undefined"`},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			path, err := Parse(tc.path)
+			require.NoError(t, err)
+			res, err := Query(path, target, vars, false /* silent */)
+			if err != nil {
+				require.Equal(t, tc.expected, err.Error())
+				return
+			}
+			items := make([]string, len(res))
+			for i, item := range res {
+				items[i] = item.String()
+			}
+			require.Equal(t, tc.expected, "["+strings.Join(items, ", ")+"]")
+		})
+	}
+}
+
+func TestSilent(t *testing.T) {
+	target, err := json.ParseJSON(`{"a": [1, 2]}`)
+	require.NoError(t, err)
+
+	path, err := Parse(`strict $.b`)
+	require.NoError(t, err)
+	res, err := Query(path, target, nil /* vars */, true /* silent */)
+	require.NoError(t, err)
+	require.Empty(t, res)
+	exists, ok, err := Exists(path, target, nil /* vars */, true /* silent */)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.False(t, exists)
+	_, _, err = Exists(path, target, nil /* vars */, false /* silent */)
+	require.Error(t, err)
+	require.Equal(t, pgcode.SQLJSONMemberNotFound, pgerror.GetPGCode(err))
+
+	// Missing variables are not suppressed.
+	path, err = Parse(`$.a ? (@ > $x)`)
+	require.NoError(t, err)
+	_, err = Query(path, target, nil /* vars */, true /* silent */)
+	require.Error(t, err)
+	require.Equal(t, pgcode.UndefinedObject, pgerror.GetPGCode(err))
+
+	notObject, err := json.ParseJSON(`[1]`)
+	require.NoError(t, err)
+	_, err = Query(path, target, notObject, true /* silent */)
+	require.Error(t, err)
+	require.Equal(t, pgcode.InvalidParameterValue, pgerror.GetPGCode(err))
+}
+
+func TestMatch(t *testing.T) {
+	target, err := json.ParseJSON(`{"a": [1, 2], "b": "x"}`)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		path      string
+		match, ok bool
+		err       string
+	}{
+		{path: `$.a[*] > 1`, match: true, ok: true},
+		{path: `$.a[*] > 2`, match: false, ok: true},
+		{path: `$.b > 1`, match: false, ok: false},
+		{path: `exists($.c)`, match: false, ok: true},
+		{path: `$.a`, err: `single boolean result is expected`},
+		{path: `$.b`, err: `single boolean result is expected`},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			path, err := Parse(tc.path)
+			require.NoError(t, err)
+			match, ok, err := Match(path, target, nil /* vars */, false /* silent */)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				_, ok, err = Match(path, target, nil /* vars */, true /* silent */)
+				require.NoError(t, err)
+				require.False(t, ok)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.match, match)
+			require.Equal(t, tc.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,853 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package jsonpath implements the SQL/JSON path language used by the
+// jsonb_path_* builtins and the SQL/JSON query functions. See
+// https://www.postgresql.org/docs/current/functions-json.html#FUNCTIONS-SQLJSON-PATH.
+package jsonpath
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/json"
+)
+
+// Path is a parsed SQL/JSON path expression.
+type Path struct {
+	// Strict is true if the path is evaluated in strict mode, in which
+	// structural errors are reported instead of being ignored.
+	Strict bool
+	expr   node
+}
+
+// node is a node of a parsed path expression.
+type node interface {
+	// isPredicate returns whether the node evaluates to a boolean that can be
+	// used in a filter, rather than to a sequence of items.
+	isPredicate() bool
+}
+
+type rootNode struct{}
+type currentNode struct{}
+type lastNode struct{}
+type varNode struct{ name string }
+type literalNode struct{ val json.JSON }
+
+// chainNode applies a series of accessors to the items produced by base.
+type chainNode struct {
+	base  node
+	steps []step
+}
+
+type binaryOp int
+
+const (
+	opAdd binaryOp = iota
+	opSub
+	opMul
+	opDiv
+	opMod
+	opEq
+	opNe
+	opLt
+	opLe
+	opGt
+	opGe
+	opAnd
+	opOr
+	opStartsWith
+)
+
+var binaryOpNames = [...]string{
+	opAdd: "+", opSub: "-", opMul: "*", opDiv: "/", opMod: "%",
+	opEq: "==", opNe: "!=", opLt: "<", opLe: "<=", opGt: ">", opGe: ">=",
+	opAnd: "&&", opOr: "||", opStartsWith: "starts with",
+}
+
+type binaryNode struct {
+	op          binaryOp
+	left, right node
+}
+
+type unaryNode struct {
+	// op is one of '+', '-' or '!'.
+	op      byte
+	operand node
+}
+
+type existsNode struct{ expr node }
+type isUnknownNode struct{ pred node }
+
+type likeRegexNode struct {
+	expr node
+	re   *regexp.Regexp
+}
+
+func (rootNode) isPredicate() bool      { return false }
+func (currentNode) isPredicate() bool   { return false }
+func (lastNode) isPredicate() bool      { return false }
+func (varNode) isPredicate() bool       { return false }
+func (literalNode) isPredicate() bool   { return false }
+func (chainNode) isPredicate() bool     { return false }
+func (existsNode) isPredicate() bool    { return true }
+func (isUnknownNode) isPredicate() bool { return true }
+func (likeRegexNode) isPredicate() bool { return true }
+
+func (n binaryNode) isPredicate() bool {
+	return n.op >= opEq
+}
+
+func (n unaryNode) isPredicate() bool {
+	return n.op == '!'
+}
+
+// step is an accessor applied to each item of a sequence.
+type step interface{ isStep() }
+
+type keyStep struct{ key string }
+type anyKeyStep struct{}
+type anyIndexStep struct{}
+type recursiveStep struct{}
+type filterStep struct{ pred node }
+type methodStep struct{ name string }
+
+// indexStep is an array accessor with a list of subscripts, each of which is
+// either a single index or an inclusive range.
+type indexStep struct{ subscripts []subscript }
+
+type subscript struct {
+	from, to node
+}
+
+func (keyStep) isStep()       {}
+func (anyKeyStep) isStep()    {}
+func (anyIndexStep) isStep()  {}
+func (recursiveStep) isStep() {}
+func (filterStep) isStep()    {}
+func (methodStep) isStep()    {}
+func (indexStep) isStep()     {}
+
+var itemMethods = map[string]bool{
+	"type":    true,
+	"size":    true,
+	"double":  true,
+	"ceiling": true,
+	"floor":   true,
+	"abs":     true,
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	// tokIdent is an unquoted identifier or keyword.
+	tokIdent
+	tokString
+	tokNumber
+	// tokVar is a named variable, such as $x.
+	tokVar
+	// tokPunct is an operator or punctuation.
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	val  string
+}
+
+func (t token) String() string {
+	if t.kind == tokString {
+		return strconv.Quote(t.val)
+	}
+	return t.val
+}
+
+// Parse parses a SQL/JSON path expression.
+func Parse(s string) (*Path, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{toks: toks}
+	var path Path
+	if p.peekIdent("strict") {
+		path.Strict = true
+		p.next()
+	} else if p.peekIdent("lax") {
+		p.next()
+	}
+	if path.expr, err = p.parseOr(); err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, p.syntaxError()
+	}
+	return &path, nil
+}
+
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case c == '"':
+			str, n, err := scanString(s[i:])
+			if err != nil {
+				return nil, err
+			}
+			toks = append(toks, token{kind: tokString, val: str})
+			i += n
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			if j+1 < len(s) && s[j] == '.' && s[j+1] >= '0' && s[j+1] <= '9' {
+				j++
+				for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+					j++
+				}
+			}
+			if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+				k := j + 1
+				if k < len(s) && (s[k] == '+' || s[k] == '-') {
+					k++
+				}
+				if k < len(s) && s[k] >= '0' && s[k] <= '9' {
+					for k < len(s) && s[k] >= '0' && s[k] <= '9' {
+						k++
+					}
+					j = k
+				}
+			}
+			if j < len(s) && isIdentStart(s[j:]) {
+				return nil, pgerror.Newf(pgcode.Syntax,
+					"trailing junk after numeric literal at or near %q of jsonpath input", s[i:j+1])
+			}
+			toks = append(toks, token{kind: tokNumber, val: s[i:j]})
+			i = j
+		case c == '$':
+			j := i + 1
+			if j < len(s) && s[j] == '"' {
+				str, n, err := scanString(s[j:])
+				if err != nil {
+					return nil, err
+				}
+				toks = append(toks, token{kind: tokVar, val: str})
+				i = j + n
+				continue
+			}
+			for j < len(s) && isIdentChar(s[j:]) {
+				_, size := utf8.DecodeRuneInString(s[j:])
+				j += size
+			}
+			if j == i+1 {
+				toks = append(toks, token{kind: tokPunct, val: "$"})
+			} else {
+				toks = append(toks, token{kind: tokVar, val: s[i+1 : j]})
+			}
+			i = j
+		case isIdentStart(s[i:]):
+			j := i
+			for j < len(s) && isIdentChar(s[j:]) {
+				_, size := utf8.DecodeRuneInString(s[j:])
+				j += size
+			}
+			toks = append(toks, token{kind: tokIdent, val: s[i:j]})
+			i = j
+		default:
+			op := ""
+			for _, candidate := range []string{
+				"==", "!=", "<>", "<=", ">=", "&&", "||", "**",
+				"<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]",
+				"{", "}", ",", ".", "?", "@",
+			} {
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				return nil, pgerror.Newf(pgcode.Syntax,
+					"syntax error at or near %q of jsonpath input", s[i:i+size])
+			}
+			if op == "<>" {
+				op = "!="
+			}
+			toks = append(toks, token{kind: tokPunct, val: op})
+			i += len(op)
+		}
+	}
+	return append(toks, token{kind: tokEOF}), nil
+}
+
+func isIdentStart(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentChar(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// scanString scans the double-quoted string at the start of s, returning its
+// unescaped value and the number of bytes consumed.
+func scanString(s string) (string, int, error) {
+	var buf strings.Builder
+	for i := 1; i < len(s); {
+		switch c := s[i]; c {
+		case '"':
+			return buf.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(s) {
+				break
+			}
+			i++
+			switch e := s[i]; e {
+			case '"', '\\', '/':
+				buf.WriteByte(e)
+			case 'b':
+				buf.WriteByte('\b')
+			case 'f':
+				buf.WriteByte('\f')
+			case 'n':
+				buf.WriteByte('\n')
+			case 'r':
+				buf.WriteByte('\r')
+			case 't':
+				buf.WriteByte('\t')
+			case 'v':
+				buf.WriteByte('\v')
+			case 'x', 'u':
+				n := 2
+				if e == 'u' {
+					n = 4
+				}
+				if i+n >= len(s) {
+					return "", 0, pgerror.Newf(pgcode.Syntax,
+						"invalid %s escape sequence in jsonpath input", string(e))
+				}
+				v, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+				if err != nil {
+					return "", 0, pgerror.Newf(pgcode.Syntax,
+						"invalid %s escape sequence in jsonpath input", string(e))
+				}
+				buf.WriteRune(rune(v))
+				i += n
+			default:
+				buf.WriteByte(e)
+			}
+			i++
+			continue
+		}
+		buf.WriteByte(s[i])
+		i++
+	}
+	return "", 0, pgerror.New(pgcode.Syntax, "unterminated quoted string in jsonpath input")
+}
+
+type parser struct {
+	toks []token
+	pos  int
+	// inSubscript is the nesting depth of array subscripts, in which last is
+	// allowed.
+	inSubscript int
+	// inFilter is the nesting depth of filters, in which @ is allowed.
+	inFilter int
+}
+
+// peek returns the next token. The token list ends with tokEOF, which is
+// returned indefinitely.
+func (p *parser) peek() token {
+	return p.toks[min(p.pos, len(p.toks)-1)]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) peekPunct(val string) bool {
+	t := p.peek()
+	return t.kind == tokPunct && t.val == val
+}
+
+func (p *parser) peekIdent(val string) bool {
+	t := p.peek()
+	return t.kind == tokIdent && t.val == val
+}
+
+func (p *parser) expectPunct(val string) error {
+	if !p.peekPunct(val) {
+		return p.syntaxError()
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) syntaxError() error {
+	t := p.peek()
+	if t.kind == tokEOF {
+		return pgerror.New(pgcode.Syntax, "syntax error at end of jsonpath input")
+	}
+	return pgerror.Newf(pgcode.Syntax, "syntax error at or near %q of jsonpath input", t.String())
+}
+
+// expectPredicate checks that n is a predicate when used as the operand of a
+// logical operator.
+func (p *parser) expectPredicate(n node) error {
+	if !n.isPredicate() {
+		return p.syntaxError()
+	}
+	return nil
+}
+
+// expectExpr checks that n is not a predicate when used as the operand of an
+// arithmetic or comparison operator.
+func (p *parser) expectExpr(n node) error {
+	if n.isPredicate() {
+		return p.syntaxError()
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekPunct("||") {
+		if err := p.expectPredicate(left); err != nil {
+			return nil, err
+		}
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPredicate(right); err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: opOr, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekPunct("&&") {
+		if err := p.expectPredicate(left); err != nil {
+			return nil, err
+		}
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPredicate(right); err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: opAnd, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if !p.peekPunct("!") {
+		return p.parseComparison()
+	}
+	p.next()
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPredicate(operand); err != nil {
+		return nil, err
+	}
+	return unaryNode{op: '!', operand: operand}, nil
+}
+
+var comparisonOps = map[string]binaryOp{
+	"==": opEq, "!=": opNe, "<": opLt, "<=": opLe, ">": opGt, ">=": opGe,
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if op, ok := comparisonOps[t.val]; ok && t.kind == tokPunct {
+		if err := p.expectExpr(left); err != nil {
+			return nil, err
+		}
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(right); err != nil {
+			return nil, err
+		}
+		return binaryNode{op: op, left: left, right: right}, nil
+	}
+	switch {
+	case p.peekIdent("starts"):
+		if err := p.expectExpr(left); err != nil {
+			return nil, err
+		}
+		p.next()
+		if !p.peekIdent("with") {
+			return nil, p.syntaxError()
+		}
+		p.next()
+		var right node
+		switch t := p.next(); t.kind {
+		case tokString:
+			right = literalNode{val: json.FromString(t.val)}
+		case tokVar:
+			right = varNode{name: t.val}
+		default:
+			p.pos--
+			return nil, p.syntaxError()
+		}
+		return binaryNode{op: opStartsWith, left: left, right: right}, nil
+
+	case p.peekIdent("like_regex"):
+		if err := p.expectExpr(left); err != nil {
+			return nil, err
+		}
+		p.next()
+		pattern := p.next()
+		if pattern.kind != tokString {
+			p.pos--
+			return nil, p.syntaxError()
+		}
+		flags := ""
+		if p.peekIdent("flag") {
+			p.next()
+			f := p.next()
+			if f.kind != tokString {
+				p.pos--
+				return nil, p.syntaxError()
+			}
+			flags = f.val
+		}
+		re, err := compileRegex(pattern.val, flags)
+		if err != nil {
+			return nil, err
+		}
+		return likeRegexNode{expr: left, re: re}, nil
+	}
+	return left, nil
+}
+
+// compileRegex compiles a like_regex pattern with the given flags.
+func compileRegex(pattern, flags string) (*regexp.Regexp, error) {
+	prefix := ""
+	for _, f := range flags {
+		switch f {
+		case 'i', 's', 'm':
+			prefix += string(f)
+		case 'q':
+			pattern = regexp.QuoteMeta(pattern)
+		default:
+			return nil, pgerror.Newf(pgcode.Syntax,
+				"invalid input syntax for type jsonpath: unrecognized flag character %q in LIKE_REGEX predicate",
+				string(f))
+		}
+	}
+	if prefix != "" {
+		pattern = "(?" + prefix + ")" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, pgerror.Wrap(err, pgcode.InvalidRegularExpression, "invalid regular expression")
+	}
+	return re, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekPunct("+") || p.peekPunct("-") {
+		op := opAdd
+		if p.next().val == "-" {
+			op = opSub
+		}
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(left); err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(right); err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekPunct("*") || p.peekPunct("/") || p.peekPunct("%") {
+		var op binaryOp
+		switch p.next().val {
+		case "*":
+			op = opMul
+		case "/":
+			op = opDiv
+		default:
+			op = opMod
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(left); err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(right); err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if !p.peekPunct("+") && !p.peekPunct("-") {
+		return p.parseAccessorExpr()
+	}
+	op := p.next().val[0]
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectExpr(operand); err != nil {
+		return nil, err
+	}
+	return unaryNode{op: op, operand: operand}, nil
+}
+
+func (p *parser) parseAccessorExpr() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	var steps []step
+	for {
+		var s step
+		switch {
+		case p.peekPunct("."):
+			p.next()
+			if s, err = p.parseMemberAccessor(); err != nil {
+				return nil, err
+			}
+		case p.peekPunct("["):
+			p.next()
+			if s, err = p.parseArrayAccessor(); err != nil {
+				return nil, err
+			}
+		case p.peekPunct("?"):
+			p.next()
+			if err := p.expectPunct("("); err != nil {
+				return nil, err
+			}
+			p.inFilter++
+			pred, err := p.parseOr()
+			p.inFilter--
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPredicate(pred); err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			s = filterStep{pred: pred}
+		default:
+			if len(steps) == 0 {
+				return base, nil
+			}
+			return chainNode{base: base, steps: steps}, nil
+		}
+		steps = append(steps, s)
+	}
+}
+
+func (p *parser) parseMemberAccessor() (step, error) {
+	t := p.next()
+	switch t.kind {
+	case tokIdent:
+		if p.peekPunct("(") {
+			if !itemMethods[t.val] {
+				return nil, pgerror.Newf(pgcode.Syntax,
+					"unsupported jsonpath item method .%s()", t.val)
+			}
+			p.next()
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			return methodStep{name: t.val}, nil
+		}
+		return keyStep{key: t.val}, nil
+	case tokString:
+		return keyStep{key: t.val}, nil
+	case tokVar:
+		// A key such as $a in a.$a is not supported, as in Postgres.
+	case tokPunct:
+		switch t.val {
+		case "*":
+			return anyKeyStep{}, nil
+		case "**":
+			return recursiveStep{}, nil
+		}
+	}
+	p.pos--
+	return nil, p.syntaxError()
+}
+
+func (p *parser) parseArrayAccessor() (step, error) {
+	if p.peekPunct("*") {
+		p.next()
+		if err := p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		return anyIndexStep{}, nil
+	}
+	p.inSubscript++
+	defer func() { p.inSubscript-- }()
+	var s indexStep
+	for {
+		from, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectExpr(from); err != nil {
+			return nil, err
+		}
+		sub := subscript{from: from}
+		if p.peekIdent("to") {
+			p.next()
+			if sub.to, err = p.parseAdditive(); err != nil {
+				return nil, err
+			}
+			if err := p.expectExpr(sub.to); err != nil {
+				return nil, err
+			}
+		}
+		s.subscripts = append(s.subscripts, sub)
+		if p.peekPunct("]") {
+			p.next()
+			return s, nil
+		}
+		if err := p.expectPunct(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return literalNode{val: json.FromString(t.val)}, nil
+	case tokNumber:
+		var d apd.Decimal
+		if _, _, err := d.SetString(t.val); err != nil {
+			return nil, pgerror.Wrapf(err, pgcode.Syntax, "invalid numeric literal %q in jsonpath input", t.val)
+		}
+		return literalNode{val: json.FromDecimal(d)}, nil
+	case tokVar:
+		return varNode{name: t.val}, nil
+	case tokIdent:
+		switch t.val {
+		case "true":
+			return literalNode{val: json.TrueJSONValue}, nil
+		case "false":
+			return literalNode{val: json.FalseJSONValue}, nil
+		case "null":
+			return literalNode{val: json.NullJSONValue}, nil
+		case "last":
+			if p.inSubscript == 0 {
+				return nil, pgerror.New(pgcode.Syntax, "LAST is allowed only in array subscripts")
+			}
+			return lastNode{}, nil
+		case "exists":
+			if err := p.expectPunct("("); err != nil {
+				return nil, err
+			}
+			expr, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectExpr(expr); err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			return existsNode{expr: expr}, nil
+		}
+	case tokPunct:
+		switch t.val {
+		case "$":
+			return rootNode{}, nil
+		case "@":
+			if p.inFilter == 0 {
+				return nil, pgerror.New(pgcode.Syntax, "@ is not allowed in root expressions")
+			}
+			return currentNode{}, nil
+		case "(":
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			if inner.isPredicate() && p.peekIdent("is") {
+				p.next()
+				if !p.peekIdent("unknown") {
+					return nil, p.syntaxError()
+				}
+				p.next()
+				return isUnknownNode{pred: inner}, nil
+			}
+			return inner, nil
+		}
+	}
+	p.pos--
+	return nil, p.syntaxError()
+}
+
+// String implements fmt.Stringer.
+func (op binaryOp) String() string {
+	return binaryOpNames[op]
+}
@@ -164,3 +164,135 @@ func Similarity(l string, r string) float64 {
 	shared := float64(nShared)
 	return shared / (float64(len(lTrigrams)+len(rTrigrams)) - shared)
 }
+
+// WordSimilarity returns the greatest similarity between the trigrams of l and
+// any continuous extent of the ordered trigrams of r. It is useful to find
+// strings that contain l as a word or part of a word.
+// See the word_similarity function in Postgres contrib/pg_trgm.
+func WordSimilarity(l string, r string) float64 {
+	return wordSimilarity(l, r, false /* strict */)
+}
+
+// StrictWordSimilarity is like WordSimilarity, but the extents of r are
+// forced to match word boundaries.
+// See the strict_word_similarity function in Postgres contrib/pg_trgm.
+func StrictWordSimilarity(l string, r string) float64 {
+	return wordSimilarity(l, r, true /* strict */)
+}
+
+// wordSimilarity is a port of calc_word_similarity and iterate_word_similarity
+// in Postgres contrib/pg_trgm/trgm_op.c. Rather than evaluating every extent of
+// r, it makes a single pass over the trigrams of r, extending the current
+// extent to the right and moving its lower bound to the right whenever that
+// increases the similarity. Extents end at trigrams shared with l or, if strict
+// is true, at word ends, and strict extents can only start at word starts.
+func wordSimilarity(l string, r string, strict bool) float64 {
+	lTrigrams := MakeTrigrams(l, true /* pad */)
+	if len(lTrigrams) == 0 {
+		return 0
+	}
+
+	// Assign an index to each distinct trigram. The trigrams of l get the
+	// indexes below len(lTrigrams), so an index identifies whether a trigram of
+	// r is shared with l.
+	indexes := make(map[string]int, len(lTrigrams))
+	for i, t := range lTrigrams {
+		indexes[t] = i
+	}
+	shared := func(idx int) bool {
+		return idx < len(lTrigrams)
+	}
+
+	// Build the ordered list of trigram indexes of r, remembering which of them
+	// start and end a word.
+	var rIndexes []int
+	var wordStart, wordEnd []bool
+	var wordTrigrams []string
+	words := strings.FieldsFunc(strings.ToLower(r), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, word := range words {
+		wordTrigrams = generateTrigrams(
+			wordTrigrams[:0], word, true /* pad */, utf8.RuneCountInString(word) == len(word),
+		)
+		for i, t := range wordTrigrams {
+			idx, ok := indexes[t]
+			if !ok {
+				idx = len(indexes)
+				indexes[t] = idx
+			}
+			rIndexes = append(rIndexes, idx)
+			wordStart = append(wordStart, i == 0)
+			wordEnd = append(wordEnd, i == len(wordTrigrams)-1)
+		}
+	}
+
+	// lastPos is the last position of each trigram in the current extent, or
+	// -1 if the trigram is not part of it. count is the number of distinct
+	// shared trigrams in the extent and extentLen the number of distinct
+	// trigrams in it.
+	lastPos := make([]int, len(indexes))
+	for i := range lastPos {
+		lastPos[i] = -1
+	}
+	lower := -1
+	if strict {
+		lower = 0
+	}
+	var count, extentLen int
+	var best float64
+	for upper, idx := range rIndexes {
+		if lower >= 0 || shared(idx) {
+			if lastPos[idx] < 0 {
+				extentLen++
+				if shared(idx) {
+					count++
+				}
+			}
+			lastPos[idx] = upper
+		}
+		if (strict && !wordEnd[upper]) || (!strict && !shared(idx)) {
+			continue
+		}
+		if lower == -1 {
+			lower = upper
+			extentLen = 1
+		}
+		cur := calcSimilarity(count, len(lTrigrams), extentLen)
+
+		// Try to move the lower bound to the right to get a greater
+		// similarity. Strict extents can only start at word starts.
+		tmpCount, tmpExtentLen, prevLower := count, extentLen, lower
+		for tmpLower := lower; tmpLower <= upper; tmpLower++ {
+			if !strict || wordStart[tmpLower] {
+				if sim := calcSimilarity(tmpCount, len(lTrigrams), tmpExtentLen); sim > cur {
+					cur = sim
+					count, extentLen, lower = tmpCount, tmpExtentLen, tmpLower
+				}
+			}
+			if tmpIdx := rIndexes[tmpLower]; lastPos[tmpIdx] == tmpLower {
+				tmpExtentLen--
+				if shared(tmpIdx) {
+					tmpCount--
+				}
+			}
+		}
+		best = max(best, cur)
+
+		// Forget the trigrams that are no longer part of the extent.
+		for tmpLower := prevLower; tmpLower < lower; tmpLower++ {
+			if tmpIdx := rIndexes[tmpLower]; lastPos[tmpIdx] == tmpLower {
+				lastPos[tmpIdx] = -1
+			}
+		}
+	}
+	return best
+}
+
+// calcSimilarity returns the similarity of two sets of trigrams with the given
+// number of distinct trigrams, of which nShared are shared.
+// See the CALCSML macro in Postgres contrib/pg_trgm/trgm.h.
+func calcSimilarity(nShared, lLen, rLen int) float64 {
+	shared := float64(nShared)
+	return shared / (float64(lLen+rLen) - shared)
+}
@@ -81,6 +81,33 @@ func TestSimilarity(t *testing.T) {
 	}
 }
 
+func TestWordSimilarity(t *testing.T) {
+	for _, tc := range []struct {
+		l          string
+		r          string
+		want       float64
+		wantStrict float64
+	}{
+		// Empty cases.
+		{"", "", 0, 0},
+		{"a", "", 0, 0},
+		{"", "a", 0, 0},
+
+		{"word", "word", 1, 1},
+		{"word", "two words", 0.8, 0.5714},
+		{"word", "WORD", 1, 1},
+		{"abc", "xyz abcd abc", 1, 1},
+		{"two words", "two words", 1, 1},
+		{"words two", "two words", 1, 1},
+		{"trigram", "trigarm", 0.5, 0.3333},
+		{"wrod", "two words", 0.2, 0.1},
+		{"abc", "xyz", 0, 0},
+	} {
+		assert.InDelta(t, tc.want, WordSimilarity(tc.l, tc.r), 0.0001, "for %s <%% %s", tc.l, tc.r)
+		assert.InDelta(t, tc.wantStrict, StrictWordSimilarity(tc.l, tc.r), 0.0001, "for %s <<%% %s", tc.l, tc.r)
+	}
+}
+
 func BenchmarkSimilarity(b *testing.B) {
 	for _, t := range []struct {
 		x string
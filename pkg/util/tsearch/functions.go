@@ -0,0 +1,288 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+)
+
+// This file contains the implementations of the text search builtins that
+// manipulate TSVectors and TSQueries directly, like setweight, strip and
+// websearch_to_tsquery.
+
+// parseVectorWeight returns the tsWeight that corresponds to a weight letter
+// passed to setweight or ts_filter. Weight D is returned as 0, since that's
+// how it's stored inside of a TSVector.
+func parseVectorWeight(w string) (tsWeight, error) {
+	switch strings.ToUpper(w) {
+	case "A":
+		return weightA, nil
+	case "B":
+		return weightB, nil
+	case "C":
+		return weightC, nil
+	case "D":
+		return 0, nil
+	}
+	return 0, pgerror.Newf(pgcode.InvalidParameterValue, "unrecognized weight: %q", w)
+}
+
+// SetWeight implements the setweight builtin, which returns a copy of the
+// input vector with the weight of every position set to the given weight. If
+// lexemes is non-nil, only the positions of the given lexemes are changed.
+func SetWeight(vector TSVector, weight string, lexemes []string) (TSVector, error) {
+	w, err := parseVectorWeight(weight)
+	if err != nil {
+		return nil, err
+	}
+	var lexemeSet map[string]struct{}
+	if lexemes != nil {
+		lexemeSet = make(map[string]struct{}, len(lexemes))
+		for _, l := range lexemes {
+			lexemeSet[l] = struct{}{}
+		}
+	}
+	ret := make(TSVector, len(vector))
+	for i, term := range vector {
+		ret[i] = term
+		if lexemeSet != nil {
+			if _, ok := lexemeSet[term.lexeme]; !ok {
+				continue
+			}
+		}
+		if len(term.positions) == 0 {
+			continue
+		}
+		positions := make([]tsPosition, len(term.positions))
+		for j, pos := range term.positions {
+			positions[j] = tsPosition{position: pos.position, weight: w}
+		}
+		ret[i].positions = positions
+	}
+	return ret, nil
+}
+
+// Strip implements the strip builtin, which returns a copy of the input vector
+// without any position or weight information.
+func Strip(vector TSVector) TSVector {
+	ret := make(TSVector, len(vector))
+	for i, term := range vector {
+		ret[i] = tsTerm{lexeme: term.lexeme}
+	}
+	return ret
+}
+
+// Delete implements the ts_delete builtin, which returns a copy of the input
+// vector without any of the given lexemes.
+func Delete(vector TSVector, lexemes []string) TSVector {
+	lexemeSet := make(map[string]struct{}, len(lexemes))
+	for _, l := range lexemes {
+		lexemeSet[l] = struct{}{}
+	}
+	ret := make(TSVector, 0, len(vector))
+	for _, term := range vector {
+		if _, ok := lexemeSet[term.lexeme]; !ok {
+			ret = append(ret, term)
+		}
+	}
+	return ret
+}
+
+// Filter implements the ts_filter builtin, which returns a copy of the input
+// vector that only contains the positions with one of the given weights.
+// Lexemes that are left without positions are removed.
+func Filter(vector TSVector, weights []string) (TSVector, error) {
+	var mask tsWeight
+	for _, weight := range weights {
+		w, err := parseVectorWeight(weight)
+		if err != nil {
+			return nil, err
+		}
+		if w == 0 {
+			w = weightD
+		}
+		mask |= w
+	}
+	ret := make(TSVector, 0, len(vector))
+	for _, term := range vector {
+		positions := filterPositionsByWeight(term.positions, mask)
+		if len(positions) == 0 {
+			continue
+		}
+		term.positions = positions
+		ret = append(ret, term)
+	}
+	return ret, nil
+}
+
+// Lexemes implements the tsvector_to_array builtin, which returns the lexemes
+// of the input vector in order.
+func Lexemes(vector TSVector) []string {
+	ret := make([]string, len(vector))
+	for i, term := range vector {
+		ret[i] = term.lexeme
+	}
+	return ret
+}
+
+// LexemesToTSVector implements the array_to_tsvector builtin, which returns a
+// vector made of the given lexemes without any positions. The lexemes are
+// sorted and de-duplicated, but not stemmed.
+func LexemesToTSVector(lexemes []string) (TSVector, error) {
+	ret := make(TSVector, len(lexemes))
+	for i, l := range lexemes {
+		if l == "" {
+			return nil, pgerror.New(pgcode.ZeroLengthCharacterString,
+				"lexeme array may not contain empty strings")
+		}
+		term, err := newLexemeTerm(l)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = term
+	}
+	return normalizeTSVector(ret)
+}
+
+// NumNodes implements the numnode builtin, which returns the number of lexemes
+// and operators in the input query.
+func NumNodes(query TSQuery) int {
+	return query.root.numNodes()
+}
+
+func (n *tsNode) numNodes() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + n.l.numNodes() + n.r.numNodes()
+}
+
+// WebSearchToTSQuery implements the websearch_to_tsquery builtin, which
+// produces a query from text written in the syntax accepted by web search
+// engines:
+//   - unquoted words are combined with the & operator,
+//   - text within double quotes is combined with the <-> operator,
+//   - the word "or" combines the operands around it with the | operator,
+//   - a leading dash negates the following word or quoted text.
+//
+// Other punctuation is ignored, so the function never returns a syntax error.
+func WebSearchToTSQuery(config string, input string) (TSQuery, error) {
+	var tokens TSVector
+	foundStopwords := false
+	// lexemes lexes the words in the input text and returns them joined by the
+	// given operator, surrounded by parentheses if there's more than one.
+	lexemes := func(text string, op tsOperator) ([]tsTerm, error) {
+		words := TSParse(text)
+		var ret []tsTerm
+		for i, word := range words {
+			lexeme, stopWord, err := TSLexize(config, word)
+			if err != nil {
+				return nil, err
+			}
+			if stopWord {
+				foundStopwords = true
+			}
+			if i > 0 {
+				term := tsTerm{operator: op}
+				if op == followedby {
+					term.followedN = 1
+				}
+				ret = append(ret, term)
+			}
+			ret = append(ret, tsTerm{lexeme: lexeme})
+		}
+		if len(words) > 1 {
+			ret = append(append([]tsTerm{{operator: lparen}}, ret...), tsTerm{operator: rparen})
+		}
+		return ret, nil
+	}
+
+	// pendingOr is set when we've seen the word "or" after an operand, and
+	// pendingNot when we've seen a dash at the start of a word.
+	var pendingOr, pendingNot bool
+	addOperand := func(operand []tsTerm) {
+		if len(operand) == 0 {
+			pendingNot = false
+			return
+		}
+		if len(tokens) > 0 {
+			if pendingOr {
+				tokens = append(tokens, tsTerm{operator: or})
+			} else {
+				tokens = append(tokens, tsTerm{operator: and})
+			}
+		}
+		if pendingNot {
+			tokens = append(tokens, tsTerm{operator: not})
+		}
+		tokens = append(tokens, operand...)
+		pendingOr, pendingNot = false, false
+	}
+
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == '"':
+			end := strings.IndexByte(input[i+1:], '"')
+			var phrase string
+			if end < 0 {
+				phrase, i = input[i+1:], len(input)
+			} else {
+				phrase, i = input[i+1:i+1+end], i+end+2
+			}
+			operand, err := lexemes(phrase, followedby)
+			if err != nil {
+				return TSQuery{}, err
+			}
+			addOperand(operand)
+		case unicode.IsSpace(rune(c)):
+			i++
+		default:
+			if c == '-' && !pendingNot && i+1 < len(input) && !unicode.IsSpace(rune(input[i+1])) {
+				pendingNot = true
+				i++
+				continue
+			}
+			end := strings.IndexFunc(input[i:], func(r rune) bool {
+				return unicode.IsSpace(r) || r == '"'
+			})
+			if end < 0 {
+				end = len(input) - i
+			}
+			word := input[i : i+end]
+			i += end
+			if strings.EqualFold(word, "or") && !pendingNot {
+				// "or" is only an operator when it's between two operands.
+				if len(tokens) > 0 {
+					pendingOr = true
+				}
+				continue
+			}
+			operand, err := lexemes(word, and)
+			if err != nil {
+				return TSQuery{}, err
+			}
+			addOperand(operand)
+		}
+	}
+	if len(tokens) == 0 {
+		return TSQuery{}, nil
+	}
+
+	queryParser := tsQueryParser{terms: tokens, input: input}
+	query, err := queryParser.parse()
+	if err != nil {
+		return query, err
+	}
+	if foundStopwords {
+		query = cleanupStopwords(query)
+	}
+	return query, nil
+}
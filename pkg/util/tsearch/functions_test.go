@@ -0,0 +1,120 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetWeight(t *testing.T) {
+	tcs := []struct {
+		input    string
+		weight   string
+		lexemes  []string
+		expected string
+	}{
+		{`a:1,2 b:3C c`, `A`, nil, `'a':1A,2A 'b':3A 'c'`},
+		{`a:1A,2B b:3C`, `d`, nil, `'a':1,2 'b':3`},
+		{`a:1 b:3 c:4`, `B`, []string{`a`, `c`, `d`}, `'a':1B 'b':3 'c':4B`},
+		{`a:1 b:3`, `B`, []string{}, `'a':1 'b':3`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			vector, err := ParseTSVector(tc.input)
+			require.NoError(t, err)
+			before := vector.String()
+			actual, err := SetWeight(vector, tc.weight, tc.lexemes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual.String())
+			// The input vector must not be modified.
+			require.Equal(t, before, vector.String())
+		})
+	}
+
+	vector, err := ParseTSVector(`a:1`)
+	require.NoError(t, err)
+	_, err = SetWeight(vector, `E`, nil)
+	require.Error(t, err)
+}
+
+func TestTSVectorFunctions(t *testing.T) {
+	vector, err := ParseTSVector(`fat:2,4B cat:3A rat:5C a:1`)
+	require.NoError(t, err)
+
+	require.Equal(t, `'a' 'cat' 'fat' 'rat'`, Strip(vector).String())
+	require.Equal(t, []string{`a`, `cat`, `fat`, `rat`}, Lexemes(vector))
+	require.Equal(t, `'a':1 'rat':5C`, Delete(vector, []string{`fat`, `cat`, `dog`}).String())
+
+	filtered, err := Filter(vector, []string{`a`, `b`})
+	require.NoError(t, err)
+	require.Equal(t, `'cat':3A 'fat':4B`, filtered.String())
+	filtered, err = Filter(vector, []string{`d`})
+	require.NoError(t, err)
+	require.Equal(t, `'a':1 'fat':2`, filtered.String())
+	_, err = Filter(vector, []string{`x`})
+	require.Error(t, err)
+
+	fromArray, err := LexemesToTSVector([]string{`fat`, `cat`, `fat`})
+	require.NoError(t, err)
+	require.Equal(t, `'cat' 'fat'`, fromArray.String())
+	_, err = LexemesToTSVector([]string{`fat`, ``})
+	require.Error(t, err)
+}
+
+func TestNumNodes(t *testing.T) {
+	tcs := []struct {
+		input    string
+		expected int
+	}{
+		{`foo`, 1},
+		{`!foo`, 2},
+		{`(fat & rat) | cat`, 5},
+		{`fat <-> !rat`, 4},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			query, err := ParseTSQuery(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, NumNodes(query))
+		})
+	}
+	require.Equal(t, 0, NumNodes(TSQuery{}))
+}
+
+func TestWebSearchToTSQuery(t *testing.T) {
+	tcs := []struct {
+		config   string
+		input    string
+		expected string
+	}{
+		{`simple`, ``, ``},
+		{`simple`, `   `, ``},
+		{`simple`, `fat rat`, `'fat' & 'rat'`},
+		{`simple`, `"supernovae stars" -crab`, `'supernovae' <-> 'stars' & !'crab'`},
+		{`simple`, `"sad cat" or "fat rat"`, `'sad' <-> 'cat' | 'fat' <-> 'rat'`},
+		{`simple`, `signal -"segmentation fault"`, `'signal' & !( 'segmentation' <-> 'fault' )`},
+		{`simple`, `fat or`, `'fat'`},
+		{`simple`, `or fat`, `'fat'`},
+		{`simple`, `fat or or rat`, `'fat' | 'rat'`},
+		{`simple`, `fat - rat`, `'fat' & 'rat'`},
+		{`simple`, `fat & rat | !cat`, `'fat' & 'rat' & 'cat'`},
+		{`simple`, `"unterminated phrase`, `'unterminated' <-> 'phrase'`},
+		{`simple`, `"" -""`, ``},
+		{`english`, `the fat rats`, `'fat' & 'rat'`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			query, err := WebSearchToTSQuery(tc.config, tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, query.String())
+		})
+	}
+
+	_, err := WebSearchToTSQuery(`nonexistent`, `fat`)
+	require.Error(t, err)
+}
@@ -271,6 +271,39 @@ func Mult(t T, t2 T) (T, error) {
 	return ret, nil
 }
 
+// L2Normalize returns t scaled to have a Euclidean norm of 1. A zero vector is
+// returned unchanged.
+func L2Normalize(t T) T {
+	ret := make(T, len(t))
+	norm := Norm(t)
+	if norm == 0 {
+		return ret
+	}
+	for i := range t {
+		ret[i] = float32(float64(t[i]) / norm)
+	}
+	return ret
+}
+
+// Subvector returns the count dimensions of t starting at the 1-based
+// position start. As in pgvector, the range is clipped to the dimensions of t,
+// but it must contain at least one of them.
+func Subvector(t T, start, count int64) (T, error) {
+	end := start + count
+	if count > 0 && end < start {
+		// Overflow.
+		end = math.MaxInt64
+	}
+	start = max(start, 1)
+	end = min(end, int64(len(t))+1)
+	if end <= start {
+		return nil, pgerror.New(pgcode.DataException, "vector must have at least 1 dimension")
+	}
+	ret := make(T, end-start)
+	copy(ret, t[start-1:end-1])
+	return ret, nil
+}
+
 // Random returns a random vector with the number of dimensions in [1, maxDim]
 // range.
 func Random(rng *rand.Rand, maxDim int) T {
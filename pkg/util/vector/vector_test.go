@@ -190,3 +190,40 @@ func TestPointwiseOps(t *testing.T) {
 		}
 	}
 }
+
+func TestL2Normalize(t *testing.T) {
+	require.Equal(t, T{0.6, 0.8}, L2Normalize(T{3, 4}))
+	require.Equal(t, T{-1, 0, 0}, L2Normalize(T{-5, 0, 0}))
+	require.Equal(t, T{0, 0}, L2Normalize(T{0, 0}))
+	require.Equal(t, T{}, L2Normalize(T{}))
+}
+
+func TestSubvector(t *testing.T) {
+	testCases := []struct {
+		start    int64
+		count    int64
+		expected T
+		err      bool
+	}{
+		{start: 1, count: 3, expected: T{1, 2, 3}},
+		{start: 2, count: 2, expected: T{2, 3}},
+		{start: 2, count: 10, expected: T{2, 3, 4, 5}},
+		{start: -1, count: 3, expected: T{1}},
+		{start: 5, count: math.MaxInt64, expected: T{5}},
+		{start: 6, count: 1, err: true},
+		{start: 1, count: 0, err: true},
+		{start: 0, count: 1, err: true},
+		{start: 2, count: -1, err: true},
+	}
+
+	v := T{1, 2, 3, 4, 5}
+	for _, tc := range testCases {
+		res, err := Subvector(v, tc.start, tc.count)
+		if tc.err {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		}
+	}
+}
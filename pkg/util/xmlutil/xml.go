@@ -0,0 +1,328 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package xmlutil implements the parsing, validation and XPath evaluation
+// used by the Postgres-compatible XML builtins.
+package xmlutil
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/errors"
+)
+
+// NodeKind is the kind of a Node.
+type NodeKind int
+
+const (
+	// DocumentNode is the root of a parsed XML value. Its children are the
+	// top-level nodes of the value.
+	DocumentNode NodeKind = iota
+	// ElementNode is an XML element.
+	ElementNode
+	// AttributeNode is an attribute of an element.
+	AttributeNode
+	// TextNode is character data, including CDATA sections.
+	TextNode
+	// CommentNode is an XML comment.
+	CommentNode
+	// ProcInstNode is a processing instruction.
+	ProcInstNode
+)
+
+// Node is a node of a parsed XML value.
+type Node struct {
+	Kind NodeKind
+	// Name is the qualified name of an element or attribute, including its
+	// namespace prefix, or the target of a processing instruction.
+	Name string
+	// Space is the namespace URI of an element or attribute, and is empty if
+	// it is not in a namespace.
+	Space string
+	// Value is the value of an attribute, text or comment node, or the
+	// instruction of a processing instruction.
+	Value string
+	// Attrs are the attributes of an element.
+	Attrs []*Node
+	// Children are the child nodes of an element or document.
+	Children []*Node
+	// Parent is the node that contains this one. It is nil for the document.
+	Parent *Node
+}
+
+// ParseDocument parses s as an XML document, which must have exactly one root
+// element. Errors report the position of the problem.
+func ParseDocument(s string) (*Node, error) {
+	return parse(s, true /* document */)
+}
+
+// ParseContent parses s as XML content, which can have any number of
+// top-level elements and text.
+func ParseContent(s string) (*Node, error) {
+	return parse(s, false /* document */)
+}
+
+func parse(s string, document bool) (*Node, error) {
+	code, what := pgcode.InvalidXMLContent, "content"
+	if document {
+		code, what = pgcode.InvalidXMLDocument, "document"
+	}
+	d := xml.NewDecoder(strings.NewReader(s))
+	syntaxError := func(msg string) error {
+		line, col := d.InputPos()
+		return errors.WithDetailf(
+			pgerror.Newf(code, "invalid XML %s", what), "line %d, column %d: %s", line, col, msg,
+		)
+	}
+
+	root := &Node{Kind: DocumentNode}
+	cur := root
+	// scopes maps namespace prefixes to URIs for each open element. The empty
+	// prefix maps to the default namespace.
+	scopes := []map[string]string{{"xml": xmlNamespace}}
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var se *xml.SyntaxError
+			if errors.As(err, &se) {
+				return nil, syntaxError(se.Msg)
+			}
+			return nil, syntaxError(err.Error())
+		}
+		var n *Node
+		switch t := tok.(type) {
+		case xml.StartElement:
+			scope, copied := scopes[len(scopes)-1], false
+			for _, a := range t.Attr {
+				if prefix, ok := declaredPrefix(a.Name); ok {
+					if !copied {
+						// Copy the parent scope before the first declaration.
+						parent := scope
+						scope = make(map[string]string, len(parent)+1)
+						for k, v := range parent {
+							scope[k] = v
+						}
+						copied = true
+					}
+					scope[prefix] = a.Value
+				}
+			}
+			scopes = append(scopes, scope)
+			n = &Node{Kind: ElementNode}
+			n.Name, n.Space = qualifiedName(scope, t.Name, true /* element */)
+			for _, a := range t.Attr {
+				attr := &Node{Kind: AttributeNode, Value: a.Value, Parent: n}
+				if prefix, ok := declaredPrefix(a.Name); !ok {
+					attr.Name, attr.Space = qualifiedName(scope, a.Name, false /* element */)
+				} else if prefix == "" {
+					attr.Name = "xmlns"
+				} else {
+					attr.Name = "xmlns:" + prefix
+				}
+				n.Attrs = append(n.Attrs, attr)
+			}
+		case xml.EndElement:
+			scopes = scopes[:len(scopes)-1]
+			cur = cur.Parent
+			continue
+		case xml.CharData:
+			if cur == root && document && strings.TrimSpace(string(t)) == "" {
+				// Whitespace around the root element is not part of the document.
+				continue
+			}
+			n = &Node{Kind: TextNode, Value: string(t)}
+		case xml.Comment:
+			n = &Node{Kind: CommentNode, Value: string(t)}
+		case xml.ProcInst:
+			if t.Target == "xml" {
+				// Skip the XML declaration.
+				continue
+			}
+			n = &Node{Kind: ProcInstNode, Name: t.Target, Value: string(t.Inst)}
+		case xml.Directive:
+			// DTDs are not supported, so we skip them.
+			continue
+		}
+		if document && cur == root && n.Kind != CommentNode && n.Kind != ProcInstNode {
+			if n.Kind != ElementNode {
+				return nil, syntaxError("text is not allowed outside of the root element")
+			}
+			for _, c := range root.Children {
+				if c.Kind == ElementNode {
+					return nil, syntaxError("extra content at the end of the document")
+				}
+			}
+		}
+		n.Parent = cur
+		cur.Children = append(cur.Children, n)
+		if n.Kind == ElementNode {
+			cur = n
+		}
+	}
+	if document {
+		hasRoot := false
+		for _, c := range root.Children {
+			hasRoot = hasRoot || c.Kind == ElementNode
+		}
+		if !hasRoot {
+			return nil, syntaxError("document is empty")
+		}
+	}
+	return root, nil
+}
+
+// xmlNamespace is the namespace URI bound to the xml prefix.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// declaredPrefix returns the namespace prefix declared by an attribute, which
+// is empty for the default namespace, and whether the attribute is a namespace
+// declaration.
+func declaredPrefix(attr xml.Name) (string, bool) {
+	switch {
+	case attr.Space == "xmlns":
+		return attr.Local, true
+	case attr.Space == "" && attr.Local == "xmlns":
+		return "", true
+	}
+	return "", false
+}
+
+// qualifiedName returns the name, including its namespace prefix, and the
+// namespace URI of an element or attribute name whose namespace was resolved
+// by the decoder. The prefix is found by looking up the namespace URI in the
+// scope of the element; the decoder leaves undeclared prefixes unresolved, in
+// which case the name has no namespace URI.
+func qualifiedName(scope map[string]string, name xml.Name, element bool) (string, string) {
+	if name.Space == "" {
+		return name.Local, ""
+	}
+	if element && scope[""] == name.Space {
+		return name.Local, name.Space
+	}
+	prefix, found := "", false
+	for p, uri := range scope {
+		// Pick the smallest matching prefix so that the result is
+		// deterministic.
+		if p != "" && uri == name.Space && (!found || p < prefix) {
+			prefix, found = p, true
+		}
+	}
+	if !found {
+		return name.Space + ":" + name.Local, ""
+	}
+	return prefix + ":" + name.Local, name.Space
+}
+
+// LocalName returns the name of an element or attribute without its namespace
+// prefix.
+func (n *Node) LocalName() string {
+	if i := strings.IndexByte(n.Name, ':'); i >= 0 {
+		return n.Name[i+1:]
+	}
+	return n.Name
+}
+
+// isNamespaceDecl returns whether the node is an attribute that declares a
+// namespace. Such attributes are not on the XPath attribute axis.
+func (n *Node) isNamespaceDecl() bool {
+	return n.Kind == AttributeNode && (n.Name == "xmlns" || strings.HasPrefix(n.Name, "xmlns:"))
+}
+
+// IsWellFormedDocument returns whether s is a well-formed XML document.
+func IsWellFormedDocument(s string) bool {
+	_, err := ParseDocument(s)
+	return err == nil
+}
+
+// IsWellFormedContent returns whether s is well-formed XML content.
+func IsWellFormedContent(s string) bool {
+	_, err := ParseContent(s)
+	return err == nil
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// String returns the serialized XML representation of the node. Attributes
+// and text are returned as their escaped values.
+func (n *Node) String() string {
+	var buf strings.Builder
+	n.write(&buf)
+	return buf.String()
+}
+
+func (n *Node) write(buf *strings.Builder) {
+	switch n.Kind {
+	case DocumentNode:
+		for _, c := range n.Children {
+			c.write(buf)
+		}
+	case ElementNode:
+		buf.WriteByte('<')
+		buf.WriteString(n.Name)
+		for _, a := range n.Attrs {
+			buf.WriteByte(' ')
+			buf.WriteString(a.Name)
+			buf.WriteString(`="`)
+			_, _ = attrEscaper.WriteString(buf, a.Value)
+			buf.WriteByte('"')
+		}
+		if len(n.Children) == 0 {
+			buf.WriteString("/>")
+			return
+		}
+		buf.WriteByte('>')
+		for _, c := range n.Children {
+			c.write(buf)
+		}
+		buf.WriteString("</")
+		buf.WriteString(n.Name)
+		buf.WriteByte('>')
+	case AttributeNode, TextNode:
+		_, _ = textEscaper.WriteString(buf, n.Value)
+	case CommentNode:
+		buf.WriteString("<!--")
+		buf.WriteString(n.Value)
+		buf.WriteString("-->")
+	case ProcInstNode:
+		buf.WriteString("<?")
+		buf.WriteString(n.Name)
+		if n.Value != "" {
+			buf.WriteByte(' ')
+			buf.WriteString(n.Value)
+		}
+		buf.WriteString("?>")
+	}
+}
+
+// StringValue returns the XPath string-value of the node: the concatenation
+// of all the text it contains.
+func (n *Node) StringValue() string {
+	switch n.Kind {
+	case AttributeNode, TextNode, CommentNode, ProcInstNode:
+		return n.Value
+	}
+	var buf strings.Builder
+	var walk func(*Node)
+	walk = func(n *Node) {
+		for _, c := range n.Children {
+			switch c.Kind {
+			case TextNode:
+				buf.WriteString(c.Value)
+			case ElementNode:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	return buf.String()
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xmlutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		input     string
+		document  bool
+		content   bool
+		formatted string
+	}{
+		{`<a/>`, true, true, `<a/>`},
+		{`<?xml version="1.0"?> <a x="1" y='&lt;2"'>t<b/>&amp;</a> `, true, true, ` <a x="1" y="&lt;2&quot;">t<b/>&amp;</a> `},
+		{`<a><![CDATA[<x>]]></a>`, true, true, `<a>&lt;x&gt;</a>`},
+		{`<!-- c --><a/><?pi data?>`, true, true, `<!-- c --><a/><?pi data?>`},
+		{`<a/><b/>`, false, true, `<a/><b/>`},
+		{`text <a/>`, false, true, `text <a/>`},
+		{`text`, false, true, `text`},
+		{``, false, true, ``},
+		{`<a>`, false, false, ``},
+		{`<a></b>`, false, false, ``},
+		{`<a x=1/>`, false, false, ``},
+		{`<a/>&bogus;`, false, false, ``},
+		{`<p:a xmlns:p="u" xmlns="d"><b p:x="1"/></p:a>`, true, true, `<p:a xmlns:p="u" xmlns="d"><b p:x="1"/></p:a>`},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.document, IsWellFormedDocument(tc.input))
+			require.Equal(t, tc.content, IsWellFormedContent(tc.input))
+			if tc.content {
+				n, err := ParseContent(tc.input)
+				require.NoError(t, err)
+				require.Equal(t, tc.formatted, n.String())
+			}
+		})
+	}
+
+	_, err := ParseDocument("<a>\n  <b>\n</a>")
+	require.Error(t, err)
+	require.Regexp(t, `invalid XML document`, err)
+}
@@ -0,0 +1,812 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xmlutil
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/errors"
+)
+
+// This file implements the subset of XPath 1.0 needed by the xpath,
+// xpath_exists and xmltable builtins:
+//   - absolute and relative location paths, using the child (/), descendant
+//     (//), self (.), parent (..) and attribute (@) axes,
+//   - name tests, which can use the namespace prefixes mapped by the caller,
+//     the * and prefix:* wildcards, and the text(), node() and comment() node
+//     tests,
+//   - predicates, which can be positions or boolean expressions made of
+//     paths, literals, numbers, the =, !=, <, <=, >, >=, and, or and |
+//     operators, and the last(), position(), count(), not(), string(),
+//     contains() and starts-with() functions.
+// As in XPath 1.0, unprefixed names only match nodes that are not in a
+// namespace. Variables and the remaining axes and functions are not supported.
+
+// XPath evaluates the XPath expression against the given document and returns
+// its result. If the expression evaluates to a node-set, the serialized nodes
+// are returned in document order; otherwise, the single result is returned as
+// a string. namespaces maps the prefixes that can be used in the expression to
+// namespace URIs.
+func XPath(expr string, doc *Node, namespaces map[string]string) ([]string, error) {
+	res, err := Eval(expr, doc, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsNodeSet {
+		return []string{res.Value}, nil
+	}
+	ret := make([]string, len(res.Nodes))
+	for i, n := range res.Nodes {
+		ret[i] = n.String()
+	}
+	return ret, nil
+}
+
+// XPathExists returns whether the XPath expression evaluates to a non-empty
+// node-set, or to a value that converts to true.
+func XPathExists(expr string, doc *Node, namespaces map[string]string) (bool, error) {
+	v, err := evalXPath(expr, doc, namespaces)
+	if err != nil {
+		return false, err
+	}
+	return toBool(v), nil
+}
+
+// Result is the result of an XPath expression, which is either a node-set or
+// a single string, number or boolean value.
+type Result struct {
+	// Nodes are the nodes of the node-set, in document order.
+	Nodes []*Node
+	// IsNodeSet is set if the expression evaluated to a node-set.
+	IsNodeSet bool
+	// Value is the result converted to a string, if it is not a node-set.
+	Value string
+}
+
+// Eval evaluates the XPath expression using the given node as the context
+// node, against which relative paths are evaluated.
+func Eval(expr string, context *Node, namespaces map[string]string) (Result, error) {
+	v, err := evalXPath(expr, context, namespaces)
+	if err != nil {
+		return Result{}, err
+	}
+	if nodes, ok := v.(nodeSet); ok {
+		return Result{Nodes: nodes, IsNodeSet: true}, nil
+	}
+	return Result{Value: toString(v)}, nil
+}
+
+func evalXPath(expr string, context *Node, namespaces map[string]string) (xpathValue, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, pgerror.New(pgcode.DataException, "empty XPath expression")
+	}
+	p := xpathParser{input: expr, namespaces: namespaces}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	root := context
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	ev := xpathEvaluator{order: make(map[*Node]int)}
+	ev.computeOrder(root)
+	return ev.eval(e, xpathContext{node: context, position: 1, size: 1})
+}
+
+// xpathValue is one of nodeSet, string, float64 or bool.
+type xpathValue interface{}
+
+// nodeSet is a list of nodes in document order, without duplicates.
+type nodeSet []*Node
+
+func toString(v xpathValue) string {
+	switch t := v.(type) {
+	case nodeSet:
+		if len(t) == 0 {
+			return ""
+		}
+		return t[0].StringValue()
+	case string:
+		return t
+	case float64:
+		if math.IsNaN(t) {
+			return "NaN"
+		}
+		if t == math.Trunc(t) && !math.IsInf(t, 0) {
+			return strconv.FormatFloat(t, 'f', -1, 64)
+		}
+		return strconv.FormatFloat(t, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	}
+	panic(errors.AssertionFailedf("unexpected XPath value %T", v))
+}
+
+func toNumber(v xpathValue) float64 {
+	switch t := v.(type) {
+	case float64:
+		return t
+	case bool:
+		if t {
+			return 1
+		}
+		return 0
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(toString(v)), 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+func toBool(v xpathValue) bool {
+	switch t := v.(type) {
+	case nodeSet:
+		return len(t) > 0
+	case string:
+		return t != ""
+	case float64:
+		return t != 0 && !math.IsNaN(t)
+	case bool:
+		return t
+	}
+	panic(errors.AssertionFailedf("unexpected XPath value %T", v))
+}
+
+type xpathToken struct {
+	text string
+	// literal is set for string literals, in which case text is the unquoted
+	// value.
+	literal bool
+}
+
+type xpathParser struct {
+	input string
+	// namespaces maps the prefixes that can be used in names to namespace
+	// URIs.
+	namespaces map[string]string
+	tokens     []xpathToken
+	pos        int
+}
+
+func (p *xpathParser) errorf(format string, args ...interface{}) error {
+	return errors.WithDetailf(
+		pgerror.Newf(pgcode.DataException, "invalid XPath expression"),
+		"%s in %q", errors.Newf(format, args...).Error(), p.input,
+	)
+}
+
+func isNameChar(r rune, first bool) bool {
+	if unicode.IsLetter(r) || r == '_' {
+		return true
+	}
+	return !first && (unicode.IsDigit(r) || r == '-' || r == '.' || r == ':')
+}
+
+// isNameStart returns whether s starts with a character that can start a
+// name.
+func isNameStart(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return isNameChar(r, true /* first */)
+}
+
+func (p *xpathParser) tokenize() error {
+	s := p.input
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return p.errorf("unterminated string literal")
+			}
+			p.tokens = append(p.tokens, xpathToken{text: s[i+1 : i+1+end], literal: true})
+			i += end + 2
+		case strings.HasPrefix(s[i:], "//") || strings.HasPrefix(s[i:], "..") ||
+			strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], "<=") ||
+			strings.HasPrefix(s[i:], ">="):
+			p.tokens = append(p.tokens, xpathToken{text: s[i : i+2]})
+			i += 2
+		case c >= '0' && c <= '9' || (c == '.' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, xpathToken{text: s[i:j]})
+			i = j
+		case strings.IndexByte("/[]()@*.|=<>,", c) >= 0:
+			p.tokens = append(p.tokens, xpathToken{text: s[i : i+1]})
+			i++
+		default:
+			j := i
+			for j < len(s) {
+				r, w := utf8.DecodeRuneInString(s[j:])
+				if !isNameChar(r, j == i) {
+					break
+				}
+				j += w
+			}
+			if j == i {
+				return p.errorf("unexpected character %q", c)
+			}
+			p.tokens = append(p.tokens, xpathToken{text: s[i:j]})
+			i = j
+		}
+	}
+	return nil
+}
+
+func (p *xpathParser) peek() (xpathToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return xpathToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekOp returns whether the next token is the given operator.
+func (p *xpathParser) peekOp(op string) bool {
+	t, ok := p.peek()
+	return ok && !t.literal && t.text == op
+}
+
+func (p *xpathParser) expect(op string) error {
+	if !p.peekOp(op) {
+		return p.errorf("expected %q", op)
+	}
+	p.pos++
+	return nil
+}
+
+// xpathExpr is a node of the parsed expression tree.
+type xpathExpr interface{}
+
+type binaryExpr struct {
+	op   string
+	l, r xpathExpr
+}
+
+type literalExpr struct {
+	val xpathValue
+}
+
+type funcExpr struct {
+	name string
+	args []xpathExpr
+}
+
+type pathExpr struct {
+	// absolute is set if the path starts at the document root.
+	absolute bool
+	steps    []step
+}
+
+type step struct {
+	// descendant is set if the step is preceded by //.
+	descendant bool
+	// axis is "child", "attribute", "self" or "parent".
+	axis string
+	// test is the local name of a name test, "*", "text()", "node()" or
+	// "comment()".
+	test string
+	// space is the namespace URI of a name test, or of a prefix:* test.
+	space string
+	// anySpace is set for the * test, which matches any namespace.
+	anySpace   bool
+	predicates []xpathExpr
+}
+
+func (p *xpathParser) parseOr() (xpathExpr, error) {
+	return p.parseBinary([]string{"or"}, p.parseAnd)
+}
+
+func (p *xpathParser) parseAnd() (xpathExpr, error) {
+	return p.parseBinary([]string{"and"}, p.parseEquality)
+}
+
+func (p *xpathParser) parseEquality() (xpathExpr, error) {
+	return p.parseBinary([]string{"=", "!="}, p.parseRelational)
+}
+
+func (p *xpathParser) parseRelational() (xpathExpr, error) {
+	return p.parseBinary([]string{"<", "<=", ">", ">="}, p.parseUnion)
+}
+
+func (p *xpathParser) parseUnion() (xpathExpr, error) {
+	return p.parseBinary([]string{"|"}, p.parsePrimary)
+}
+
+func (p *xpathParser) parseBinary(
+	ops []string, next func() (xpathExpr, error),
+) (xpathExpr, error) {
+	l, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		found := ""
+		for _, op := range ops {
+			if p.peekOp(op) {
+				found = op
+			}
+		}
+		if found == "" {
+			return l, nil
+		}
+		p.pos++
+		r, err := next()
+		if err != nil {
+			return nil, err
+		}
+		l = &binaryExpr{op: found, l: l, r: r}
+	}
+}
+
+var xpathFuncs = map[string]int{
+	"last":        0,
+	"position":    0,
+	"count":       1,
+	"not":         1,
+	"string":      1,
+	"contains":    2,
+	"starts-with": 2,
+	"true":        0,
+	"false":       0,
+}
+
+func (p *xpathParser) parsePrimary() (xpathExpr, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, p.errorf("unexpected end of expression")
+	}
+	switch {
+	case t.literal:
+		p.pos++
+		return &literalExpr{val: t.text}, nil
+	case t.text[0] >= '0' && t.text[0] <= '9' || (len(t.text) > 1 && t.text[0] == '.' && t.text[1] != '.'):
+		p.pos++
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", t.text)
+		}
+		return &literalExpr{val: f}, nil
+	case t.text == "(":
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	}
+	if nArgs, ok := xpathFuncs[t.text]; ok && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "(" {
+		p.pos += 2
+		f := &funcExpr{name: t.text}
+		for !p.peekOp(")") {
+			if len(f.args) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			f.args = append(f.args, arg)
+		}
+		p.pos++
+		if len(f.args) != nArgs && !(f.name == "string" && len(f.args) == 0) {
+			return nil, p.errorf("wrong number of arguments for %s()", f.name)
+		}
+		return f, nil
+	}
+	return p.parsePath()
+}
+
+func (p *xpathParser) parsePath() (xpathExpr, error) {
+	path := &pathExpr{}
+	descendant := false
+	switch {
+	case p.peekOp("/"):
+		p.pos++
+		path.absolute = true
+		if t, ok := p.peek(); !ok || !p.startsStep(t) {
+			// The path "/" selects the document root.
+			return path, nil
+		}
+	case p.peekOp("//"):
+		p.pos++
+		path.absolute = true
+		descendant = true
+	}
+	for {
+		s, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		s.descendant = descendant
+		path.steps = append(path.steps, s)
+		switch {
+		case p.peekOp("/"):
+			descendant = false
+		case p.peekOp("//"):
+			descendant = true
+		default:
+			return path, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *xpathParser) startsStep(t xpathToken) bool {
+	if t.literal {
+		return false
+	}
+	return t.text == "." || t.text == ".." || t.text == "@" || t.text == "*" ||
+		isNameStart(t.text)
+}
+
+func (p *xpathParser) parseStep() (step, error) {
+	t, ok := p.peek()
+	if !ok || !p.startsStep(t) {
+		return step{}, p.errorf("expected a location step")
+	}
+	p.pos++
+	s := step{axis: "child"}
+	switch t.text {
+	case ".":
+		return step{axis: "self", test: "node()"}, nil
+	case "..":
+		return step{axis: "parent", test: "node()"}, nil
+	case "@":
+		s.axis = "attribute"
+		t, ok = p.peek()
+		if !ok || t.literal || (t.text != "*" && !isNameStart(t.text)) {
+			return step{}, p.errorf("expected an attribute name")
+		}
+		p.pos++
+	}
+	s.test = t.text
+	if s.axis == "child" && (t.text == "text" || t.text == "node" || t.text == "comment") && p.peekOp("(") {
+		p.pos++
+		if err := p.expect(")"); err != nil {
+			return step{}, err
+		}
+		s.test = t.text + "()"
+	} else if t.text == "*" {
+		s.anySpace = true
+	} else if i := strings.IndexByte(t.text, ':'); i >= 0 {
+		prefix := t.text[:i]
+		uri, ok := p.namespaces[prefix]
+		if !ok && prefix == "xml" {
+			// The xml prefix is always bound.
+			uri, ok = xmlNamespace, true
+		}
+		if !ok {
+			return step{}, p.errorf("undefined namespace prefix %q", prefix)
+		}
+		s.space, s.test = uri, t.text[i+1:]
+		if s.test == "" {
+			// The tokenizer splits prefix:* into the prefix and the wildcard.
+			if !p.peekOp("*") {
+				return step{}, p.errorf("expected a name after %q", t.text)
+			}
+			p.pos++
+			s.test = "*"
+		}
+	}
+	for p.peekOp("[") {
+		p.pos++
+		pred, err := p.parseOr()
+		if err != nil {
+			return step{}, err
+		}
+		if err := p.expect("]"); err != nil {
+			return step{}, err
+		}
+		s.predicates = append(s.predicates, pred)
+	}
+	return s, nil
+}
+
+type xpathContext struct {
+	node           *Node
+	position, size int
+}
+
+type xpathEvaluator struct {
+	// order maps each node to its position in document order.
+	order map[*Node]int
+}
+
+func (ev *xpathEvaluator) computeOrder(n *Node) {
+	ev.order[n] = len(ev.order)
+	for _, a := range n.Attrs {
+		ev.order[a] = len(ev.order)
+	}
+	for _, c := range n.Children {
+		ev.computeOrder(c)
+	}
+}
+
+// sortNodes sorts the nodes in document order and removes duplicates.
+func (ev *xpathEvaluator) sortNodes(nodes nodeSet) nodeSet {
+	sort.Slice(nodes, func(i, j int) bool {
+		return ev.order[nodes[i]] < ev.order[nodes[j]]
+	})
+	ret := nodes[:0]
+	for i, n := range nodes {
+		if i == 0 || n != nodes[i-1] {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+func (ev *xpathEvaluator) eval(e xpathExpr, ctx xpathContext) (xpathValue, error) {
+	switch t := e.(type) {
+	case *literalExpr:
+		return t.val, nil
+	case *pathExpr:
+		return ev.evalPath(t, ctx)
+	case *funcExpr:
+		return ev.evalFunc(t, ctx)
+	case *binaryExpr:
+		l, err := ev.eval(t.l, ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch t.op {
+		case "or":
+			if toBool(l) {
+				return true, nil
+			}
+		case "and":
+			if !toBool(l) {
+				return false, nil
+			}
+		}
+		r, err := ev.eval(t.r, ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch t.op {
+		case "or", "and":
+			return toBool(r), nil
+		case "|":
+			ln, lok := l.(nodeSet)
+			rn, rok := r.(nodeSet)
+			if !lok || !rok {
+				return nil, pgerror.New(pgcode.DataException, "the operands of | must be node-sets")
+			}
+			return ev.sortNodes(append(append(nodeSet(nil), ln...), rn...)), nil
+		}
+		return compareValues(t.op, l, r), nil
+	}
+	return nil, errors.AssertionFailedf("unexpected XPath expression %T", e)
+}
+
+// compareValues implements the XPath comparison operators. Comparisons
+// involving node-sets are true if they hold for any of the nodes.
+func compareValues(op string, l, r xpathValue) bool {
+	if ln, ok := l.(nodeSet); ok {
+		for _, n := range ln {
+			if compareValues(op, n.StringValue(), r) {
+				return true
+			}
+		}
+		return false
+	}
+	if rn, ok := r.(nodeSet); ok {
+		for _, n := range rn {
+			if compareValues(op, l, n.StringValue()) {
+				return true
+			}
+		}
+		return false
+	}
+	switch op {
+	case "=", "!=":
+		var eq bool
+		_, lBool := l.(bool)
+		_, rBool := r.(bool)
+		_, lNum := l.(float64)
+		_, rNum := r.(float64)
+		switch {
+		case lBool || rBool:
+			eq = toBool(l) == toBool(r)
+		case lNum || rNum:
+			eq = toNumber(l) == toNumber(r)
+		default:
+			eq = toString(l) == toString(r)
+		}
+		return eq == (op == "=")
+	}
+	lf, rf := toNumber(l), toNumber(r)
+	switch op {
+	case "<":
+		return lf < rf
+	case "<=":
+		return lf <= rf
+	case ">":
+		return lf > rf
+	}
+	return lf >= rf
+}
+
+func (ev *xpathEvaluator) evalFunc(f *funcExpr, ctx xpathContext) (xpathValue, error) {
+	args := make([]xpathValue, len(f.args))
+	for i := range f.args {
+		var err error
+		if args[i], err = ev.eval(f.args[i], ctx); err != nil {
+			return nil, err
+		}
+	}
+	switch f.name {
+	case "last":
+		return float64(ctx.size), nil
+	case "position":
+		return float64(ctx.position), nil
+	case "count":
+		nodes, ok := args[0].(nodeSet)
+		if !ok {
+			return nil, pgerror.New(pgcode.DataException, "the argument of count() must be a node-set")
+		}
+		return float64(len(nodes)), nil
+	case "not":
+		return !toBool(args[0]), nil
+	case "string":
+		if len(args) == 0 {
+			return ctx.node.StringValue(), nil
+		}
+		return toString(args[0]), nil
+	case "contains":
+		return strings.Contains(toString(args[0]), toString(args[1])), nil
+	case "starts-with":
+		return strings.HasPrefix(toString(args[0]), toString(args[1])), nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return nil, errors.AssertionFailedf("unexpected XPath function %s", f.name)
+}
+
+func (ev *xpathEvaluator) evalPath(p *pathExpr, ctx xpathContext) (xpathValue, error) {
+	cur := nodeSet{ctx.node}
+	if p.absolute {
+		root := ctx.node
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		cur = nodeSet{root}
+	}
+	for _, s := range p.steps {
+		origins := cur
+		if s.descendant {
+			origins = descendantsOrSelf(cur)
+		}
+		// Different origins can select the same node, for example the parent
+		// of siblings, so the matches are de-duplicated as they are collected
+		// to keep each step linear in the size of the document.
+		var next nodeSet
+		seen := make(map[*Node]struct{})
+		for _, o := range origins {
+			matches, err := ev.evalStep(s, o)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range matches {
+				if _, ok := seen[m]; !ok {
+					seen[m] = struct{}{}
+					next = append(next, m)
+				}
+			}
+		}
+		cur = ev.sortNodes(next)
+	}
+	return cur, nil
+}
+
+// descendantsOrSelf returns the given nodes and all their descendants, without
+// duplicates. Subtrees that were already visited are skipped, so that nested
+// nodes don't cause their common descendants to be walked more than once.
+func descendantsOrSelf(nodes nodeSet) nodeSet {
+	var ret nodeSet
+	visited := make(map[*Node]struct{})
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		if _, ok := visited[n]; ok {
+			return
+		}
+		visited[n] = struct{}{}
+		ret = append(ret, n)
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	return ret
+}
+
+// evalStep returns the nodes selected by the step from the given node.
+func (ev *xpathEvaluator) evalStep(s step, n *Node) (nodeSet, error) {
+	var candidates nodeSet
+	switch s.axis {
+	case "self":
+		candidates = nodeSet{n}
+	case "parent":
+		if n.Parent != nil {
+			candidates = nodeSet{n.Parent}
+		}
+	case "attribute":
+		for _, a := range n.Attrs {
+			if !a.isNamespaceDecl() {
+				candidates = append(candidates, a)
+			}
+		}
+	default:
+		candidates = n.Children
+	}
+	var matches nodeSet
+	for _, c := range candidates {
+		if matchesTest(s, c) {
+			matches = append(matches, c)
+		}
+	}
+	for _, pred := range s.predicates {
+		var filtered nodeSet
+		for i, m := range matches {
+			v, err := ev.eval(pred, xpathContext{node: m, position: i + 1, size: len(matches)})
+			if err != nil {
+				return nil, err
+			}
+			if f, ok := v.(float64); ok {
+				if f == float64(i+1) {
+					filtered = append(filtered, m)
+				}
+			} else if toBool(v) {
+				filtered = append(filtered, m)
+			}
+		}
+		matches = filtered
+	}
+	return matches, nil
+}
+
+func matchesTest(s step, n *Node) bool {
+	switch s.test {
+	case "node()":
+		return true
+	case "text()":
+		return n.Kind == TextNode
+	case "comment()":
+		return n.Kind == CommentNode
+	}
+	if n.Kind != ElementNode && n.Kind != AttributeNode {
+		return false
+	}
+	if s.test == "*" {
+		return s.anySpace || n.Space == s.space
+	}
+	return n.LocalName() == s.test && n.Space == s.space
+}
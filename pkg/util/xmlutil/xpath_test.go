@@ -0,0 +1,153 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package xmlutil
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXPath(t *testing.T) {
+	doc, err := ParseDocument(`<library>
+<book id="1" lang="en"><title>Dune</title><year>1965</year></book>
+<book id="2" lang="fr"><title>Vendredi</title><year>1967</year></book>
+<book id="3"><title>Emma</title><year>1815</year><!-- classic --></book>
+</library>`)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		expr     string
+		expected []string
+	}{
+		{`/library/book/title`, []string{`<title>Dune</title>`, `<title>Vendredi</title>`, `<title>Emma</title>`}},
+		{`//title/text()`, []string{`Dune`, `Vendredi`, `Emma`}},
+		{`/library/book[1]/title/text()`, []string{`Dune`}},
+		{`/library/book[last()]/@id`, []string{`3`}},
+		{`//book[@lang='fr']/title/text()`, []string{`Vendredi`}},
+		{`//book[@lang]/@id`, []string{`1`, `2`}},
+		{`//book[not(@lang)]/@id`, []string{`3`}},
+		{`//book[year > 1900 and year < 1966]/@id`, []string{`1`}},
+		{`//book[title = "Emma" or @id = 1]/@id`, []string{`1`, `3`}},
+		{`//book[starts-with(title, 'V')]/@id`, []string{`2`}},
+		{`//book[contains(title, 'm')]/@id`, []string{`3`}},
+		{`//year/..//title/text()`, []string{`Dune`, `Vendredi`, `Emma`}},
+		{`//book[2]/*`, []string{`<title>Vendredi</title>`, `<year>1967</year>`}},
+		{`//book[3]/node()`, []string{`<title>Emma</title>`, `<year>1815</year>`, `<!-- classic -->`}},
+		{`//book[3]/comment()`, []string{`<!-- classic -->`}},
+		{`//book/@*`, []string{`1`, `en`, `2`, `fr`, `3`}},
+		{`//title | //year[. = 1967]`, []string{`<title>Dune</title>`, `<title>Vendredi</title>`, `<year>1967</year>`, `<title>Emma</title>`}},
+		{`//book[position() = 2]/title/./text()`, []string{`Vendredi`}},
+		{`count(//book)`, []string{`3`}},
+		{`count(//book) > 2`, []string{`true`}},
+		{`string(//book[1]/title)`, []string{`Dune`}},
+		{`//magazine`, []string{}},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			res, err := XPath(tc.expr, doc, nil /* namespaces */)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+
+	exists, err := XPathExists(`//book[@id = 2]`, doc, nil /* namespaces */)
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = XPathExists(`//book[@id = 4]`, doc, nil /* namespaces */)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	for _, expr := range []string{
+		``,
+		`//`,
+		`/library/`,
+		`//book[`,
+		`//book[1`,
+		`//book]`,
+		`count()`,
+		`'unterminated`,
+		`//book/@`,
+		`!`,
+		`count('a')`,
+		`'a' | //book`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := XPath(expr, doc, nil /* namespaces */)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestXPathNamespaces(t *testing.T) {
+	doc, err := ParseDocument(`<lib xmlns="urn:lib" xmlns:m="urn:meta">
+<book m:id="1"><title>Dune</title></book>
+<m:note xml:lang="en">classic</m:note>
+</lib>`)
+	require.NoError(t, err)
+	namespaces := map[string]string{"l": "urn:lib", "meta": "urn:meta"}
+
+	for _, tc := range []struct {
+		expr     string
+		expected []string
+	}{
+		{`/l:lib/l:book/l:title/text()`, []string{`Dune`}},
+		{`//l:book/@meta:id`, []string{`1`}},
+		{`//meta:*`, []string{`<m:note xml:lang="en">classic</m:note>`}},
+		{`/l:lib/*/@*`, []string{`1`, `en`}},
+		{`//meta:note/@xml:lang`, []string{`en`}},
+		// Unprefixed names only match nodes without a namespace.
+		{`/lib/book`, []string{}},
+		{`count(//l:*)`, []string{`3`}},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			res, err := XPath(tc.expr, doc, namespaces)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+
+	_, err = XPath(`//x:book`, doc, namespaces)
+	require.Regexp(t, `undefined namespace prefix "x"`, errors.FlattenDetails(err))
+}
+
+func TestXPathNestedDescendants(t *testing.T) {
+	// Each // step over nested elements would select the same descendants
+	// from every ancestor if the node-sets weren't de-duplicated per step.
+	var buf strings.Builder
+	const depth = 200
+	for i := 0; i < depth; i++ {
+		buf.WriteString("<a>")
+	}
+	for i := 0; i < depth; i++ {
+		buf.WriteString("</a>")
+	}
+	doc, err := ParseDocument(buf.String())
+	require.NoError(t, err)
+	res, err := XPath(`count(//*//*//*//*)`, doc, nil /* namespaces */)
+	require.NoError(t, err)
+	require.Equal(t, []string{strconv.Itoa(depth - 3)}, res)
+}
+
+func TestEval(t *testing.T) {
+	doc, err := ParseDocument(`<r><i n="1">a</i><i n="2">b</i></r>`)
+	require.NoError(t, err)
+	rows, err := Eval(`/r/i`, doc, nil /* namespaces */)
+	require.NoError(t, err)
+	require.True(t, rows.IsNodeSet)
+	require.Len(t, rows.Nodes, 2)
+
+	// Relative paths are evaluated against the context node.
+	res, err := Eval(`@n`, rows.Nodes[1], nil /* namespaces */)
+	require.NoError(t, err)
+	require.Equal(t, "2", res.Nodes[0].StringValue())
+	res, err = Eval(`count(../i)`, rows.Nodes[1], nil /* namespaces */)
+	require.NoError(t, err)
+	require.False(t, res.IsNodeSet)
+	require.Equal(t, "2", res.Value)
+}